@@ -0,0 +1,58 @@
+package paystack
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// A monetary amount in the smallest unit of its currency, e.g. kobo instead of NGN.
+// Amounts are decoded exactly from JSON numbers or integer strings, never through float64, and decoding fails instead of truncating when a value is fractional or does not fit in an int64.
+// Strings with a decimal point, such as "1500.00", are rejected, as they are most likely in the currency's main unit.
+type Amount int64
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		s := string(data[1 : len(data)-1])
+		if s == "" {
+			*a = 0
+			return nil
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid amount %q: not an integer in the currency's smallest unit", s)
+		}
+		*a = Amount(n)
+		return nil
+	}
+	s := string(data)
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*a = Amount(n)
+		return nil
+	}
+	// Not a plain int64, e.g. 1500.0, 1.5e3 or too large. Parse it exactly to tell which,
+	// after rejecting exponents big enough to make that parse expensive.
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		if exp, err := strconv.Atoi(s[i+1:]); err != nil || exp > 64 || exp < -64 {
+			return fmt.Errorf("invalid amount %q", s)
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return fmt.Errorf("invalid amount %q", s)
+	}
+	if !r.IsInt() {
+		return fmt.Errorf("amount %s is not a whole number of the currency's smallest unit", s)
+	}
+	if !r.Num().IsInt64() {
+		return fmt.Errorf("amount %s overflows int64", s)
+	}
+	*a = Amount(r.Num().Int64())
+	return nil
+}
@@ -0,0 +1,50 @@
+package paystack
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAmountUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json    string
+		want    Amount
+		wantErr bool
+	}{
+		{json: `9223372036854775807`, want: 9223372036854775807},
+		{json: `"9223372036854775807"`, want: 9223372036854775807},
+		{json: `9223372036854775808`, wantErr: true},
+		{json: `-9223372036854775808`, want: -9223372036854775808},
+		{json: `-9223372036854775809`, wantErr: true},
+		{json: `150000`, want: 150000},
+		{json: `"1500.00"`, wantErr: true},
+		{json: `"1.5e3"`, wantErr: true},
+		{json: `"9223372036854775808"`, wantErr: true},
+		{json: `1500.0`, want: 1500},
+		{json: `1.5`, wantErr: true},
+		{json: `1e3`, want: 1000},
+		{json: `1.5e3`, want: 1500},
+		{json: `1e400`, wantErr: true},
+		{json: `null`, want: 42},
+		{json: `""`, want: 0},
+		{json: `"abc"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			a := Amount(42)
+			err := json.Unmarshal([]byte(tt.json), &a)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %d, want an error", a)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if a != tt.want {
+				t.Errorf("got %d, want %d", a, tt.want)
+			}
+		})
+	}
+}
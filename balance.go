@@ -0,0 +1,53 @@
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+type Balance struct {
+	Currency string `json:"currency"`
+	Balance  Amount `json:"balance"`
+}
+
+// Returns the integration's available balance in each of its currencies.
+func (c *Client) CheckBalance(ctx context.Context) ([]*Balance, error) {
+	type CheckBalanceResp struct {
+		Data []*Balance `json:"data"`
+	}
+	url := "https://api.paystack.co/balance"
+	respBody := &CheckBalanceResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type LedgerEntry struct {
+	Id       int    `json:"id"`
+	Currency string `json:"currency"`
+	// The balance after this entry was applied.
+	Balance Amount `json:"balance"`
+	// How much the balance moved by. Negative for debits.
+	Difference       Amount    `json:"difference"`
+	Reason           string    `json:"reason"`
+	ModelResponsible string    `json:"model_responsible"`
+	ModelRow         int       `json:"model_row"`
+	Domain           string    `json:"domain"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+// Lists the entries explaining how the integration's balance moved, optionally filtered by date.
+func (c *Client) ListBalanceLedger(ctx context.Context, opts *ListOptions) ([]*LedgerEntry, *Meta, error) {
+	type ListBalanceLedgerResp struct {
+		Data []*LedgerEntry `json:"data"`
+		Meta *Meta          `json:"meta"`
+	}
+	url := withQuery("https://api.paystack.co/balance/ledger", opts.values())
+	respBody := &ListBalanceLedgerResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
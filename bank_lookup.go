@@ -0,0 +1,134 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Words that vendors commonly add to or leave out of bank names.
+var bankNameNoise = map[string]bool{
+	"bank": true, "plc": true, "limited": true, "ltd": true, "of": true, "the": true, "and": true,
+}
+
+// Resolves a bank name or slug as written by a person, e.g. "First Bank" or "gtbank", to the code of the matching bank in the given country.
+// Names are matched ignoring case, punctuation and words like "bank" and "plc", falling back to the closest name within a small edit distance.
+// Configure a cache with WithCache to avoid listing banks on every lookup.
+func (c *Client) LookupBankCode(ctx context.Context, country string, name string) (string, error) {
+	banks := []*Bank{}
+	opts := &ListBanksOptions{Country: country, PerPage: 100}
+	for {
+		page, meta, err := c.ListBanks(ctx, opts)
+		if err != nil {
+			return "", err
+		}
+		banks = append(banks, page...)
+		if meta == nil || meta.Next == "" || len(page) == 0 {
+			break
+		}
+		opts.Next = meta.Next
+	}
+	bank, err := matchBank(banks, name)
+	if err != nil {
+		return "", err
+	}
+	return bank.Code, nil
+}
+
+func matchBank(banks []*Bank, name string) (*Bank, error) {
+	for _, bank := range banks {
+		if strings.EqualFold(bank.Slug, name) || strings.EqualFold(bank.Name, name) || bank.Code == name {
+			return bank, nil
+		}
+	}
+
+	want := normalizeBankName(name)
+	if want == "" {
+		return nil, fmt.Errorf("no bank matches %q", name)
+	}
+	var matches []*Bank
+	for _, bank := range banks {
+		if normalizeBankName(bank.Name) == want || normalizeBankName(bank.Slug) == want {
+			matches = append(matches, bank)
+		}
+	}
+	if len(matches) == 0 {
+		compactWant := compactBankName(name)
+		for _, bank := range banks {
+			got := normalizeBankName(bank.Name)
+			if got != "" && (strings.Contains(got, want) || strings.Contains(want, got)) ||
+				strings.Contains(compactBankName(bank.Name), compactWant) {
+				matches = append(matches, bank)
+			}
+		}
+	}
+	if len(matches) == 0 {
+		best := len(want)/4 + 1
+		for _, bank := range banks {
+			distance := levenshtein(normalizeBankName(bank.Name), want)
+			if distance < best {
+				best = distance
+				matches = []*Bank{bank}
+			} else if distance == best && len(matches) > 0 {
+				matches = append(matches, bank)
+			}
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no bank matches %q", name)
+	case 1:
+		return matches[0], nil
+	}
+	names := make([]string, len(matches))
+	for i, bank := range matches {
+		names[i] = bank.Name
+	}
+	return nil, fmt.Errorf("%q matches multiple banks: %s", name, strings.Join(names, ", "))
+}
+
+// Returns the name in lower case with everything but letters and digits removed.
+func compactBankName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// Returns the compact name with noise words such as "bank" and "plc" removed.
+func normalizeBankName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	kept := words[:0]
+	for _, word := range words {
+		if !bankNameNoise[word] {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, "")
+}
+
+func levenshtein(a string, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		cur := make([]int, len(br)+1)
+		cur[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(br)]
+}
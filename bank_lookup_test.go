@@ -0,0 +1,47 @@
+package paystack
+
+import "testing"
+
+func TestMatchBank(t *testing.T) {
+	banks := []*Bank{
+		{Name: "First Bank of Nigeria", Slug: "first-bank-of-nigeria", Code: "011"},
+		{Name: "Guaranty Trust Bank", Slug: "guaranty-trust-bank", Code: "058"},
+		{Name: "Zenith Bank", Slug: "zenith-bank", Code: "057"},
+		{Name: "United Bank For Africa", Slug: "united-bank-for-africa", Code: "033"},
+		{Name: "Union Bank of Nigeria", Slug: "union-bank-of-nigeria", Code: "032"},
+	}
+	tests := []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "Zenith Bank", want: "057"},
+		{name: "zenith-bank", want: "057"},
+		{name: "058", want: "058"},
+		{name: "ZENITH BANK PLC", want: "057"},
+		{name: "FIRSTBANK", want: "011"},
+		{name: "Guaranty Trust", want: "058"},
+		{name: "Zenit Bank", want: "057"},
+		{name: "Union", want: "032"},
+		{name: "Access Bank", wantErr: true},
+		{name: "Bank", wantErr: true},
+		{name: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bank, err := matchBank(banks, tt.name)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("got %s, want an error", bank.Code)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bank.Code != tt.want {
+				t.Errorf("got %s, want %s", bank.Code, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type RunBulkChargeOptions struct {
+	// Maximum number of charges submitted per batch. Defaults to 1000.
+	BatchSize int
+	// How often batch progress is checked. Defaults to 30 seconds.
+	PollInterval time.Duration
+	// Called once for every charge that failed after its batch finished processing.
+	OnFailedCharge func(ctx context.Context, charge *BulkCharge)
+	// The batches returned by an earlier run with the same charges and BatchSize that stopped early.
+	// Their charges are not submitted again; the run refetches these batches, waits for them and submits the rest.
+	// Failed charges of batches that were already complete are not reported again.
+	Resume []*BulkChargeBatch
+}
+
+// Returned by RunBulkCharge when a batch is paused, e.g. with PauseBulkChargeBatch, so it would not finish processing.
+var ErrBulkChargeBatchPaused = errors.New("paystack: bulk charge batch paused")
+
+// Splits charges into batches, submits them, and waits for every batch to finish processing, calling OnFailedCharge for each failed charge.
+// Every charge needs a reference, so charges resubmitted by mistake are rejected as duplicates rather than charged twice.
+// Returns the state of each batch, or the batches submitted so far if an error occurs, a batch is paused or ctx is done.
+// Pass those batches as Resume to continue the run without charging them again.
+func (c *Client) RunBulkCharge(ctx context.Context, charges []*BulkChargeItem, opts *RunBulkChargeOptions) ([]*BulkChargeBatch, error) {
+	if opts == nil {
+		opts = &RunBulkChargeOptions{}
+	}
+	checks := &checks{}
+	for i, charge := range charges {
+		if charge == nil {
+			checks.add(fmt.Sprintf("charges[%d]", i), "is required")
+		} else if charge.Reference == "" {
+			checks.add(fmt.Sprintf("charges[%d].reference", i), "is required")
+		}
+	}
+	if err := checks.err(); err != nil {
+		return nil, err
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 30 * time.Second
+	}
+
+	batches := append([]*BulkChargeBatch{}, opts.Resume...)
+	for start := len(batches) * batchSize; start < len(charges); start += batchSize {
+		end := min(start+batchSize, len(charges))
+		batch, err := c.InitiateBulkCharge(ctx, charges[start:end])
+		if err != nil {
+			return batches, err
+		}
+		batches = append(batches, batch)
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for i := range batches {
+		batch := batches[i]
+		if i < len(opts.Resume) {
+			// A resumed batch that is already complete was waited for and reported by the run that returned it.
+			if batch.Status == "complete" {
+				continue
+			}
+			// Its status is as old as that run, e.g. paused before ResumeBulkChargeBatch was called.
+			fetched, err := c.FetchBulkChargeBatch(ctx, batch.BatchCode)
+			if err != nil {
+				return batches, err
+			}
+			batch = fetched
+		}
+		for batch.Status != "complete" {
+			batches[i] = batch
+			if batch.Status == "paused" {
+				return batches, fmt.Errorf("%w: %s", ErrBulkChargeBatchPaused, batch.BatchCode)
+			}
+			select {
+			case <-ctx.Done():
+				return batches, ctx.Err()
+			case <-ticker.C:
+			}
+			fetched, err := c.FetchBulkChargeBatch(ctx, batch.BatchCode)
+			if err != nil {
+				return batches, err
+			}
+			batch = fetched
+		}
+		if opts.OnFailedCharge != nil {
+			if err := c.eachFailedBulkCharge(ctx, batch.BatchCode, opts.OnFailedCharge); err != nil {
+				return batches, err
+			}
+		}
+		// Only marked complete once its failed charges are reported, so a resumed run reports them if this one stopped first.
+		batches[i] = batch
+	}
+	return batches, nil
+}
+
+func (c *Client) eachFailedBulkCharge(ctx context.Context, batchCode string, fn func(context.Context, *BulkCharge)) error {
+	opts := &ListBulkChargesOptions{Status: "failed", ListOptions: ListOptions{PerPage: 100, Page: 1}}
+	for {
+		failed, meta, err := c.ListBulkCharges(ctx, batchCode, opts)
+		if err != nil {
+			return err
+		}
+		for _, charge := range failed {
+			fn(ctx, charge)
+		}
+		if meta == nil || opts.Page >= meta.PageCount || len(failed) == 0 {
+			return nil
+		}
+		opts.Page++
+	}
+}
@@ -0,0 +1,120 @@
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+type BulkChargeItem struct {
+	// The authorization code of the card to charge.
+	Authorization string `json:"authorization"`
+	// Amount is in the smallest unit, e.g. kobo instead of NGN.
+	Amount    int64  `json:"amount"`
+	Reference string `json:"reference,omitempty"`
+}
+
+type bulkChargeItems []*BulkChargeItem
+
+type BulkChargeBatch struct {
+	Id             int       `json:"id"`
+	BatchCode      string    `json:"batch_code"`
+	Reference      string    `json:"reference"`
+	Status         string    `json:"status"`
+	TotalCharges   int       `json:"total_charges"`
+	PendingCharges int       `json:"pending_charges"`
+	Domain         string    `json:"domain"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+// Queues a batch of charges against existing authorizations. Use the returned batch code to track its progress.
+func (c *Client) InitiateBulkCharge(ctx context.Context, charges []*BulkChargeItem) (*BulkChargeBatch, error) {
+	type InitiateBulkChargeResp struct {
+		Data *BulkChargeBatch `json:"data"`
+	}
+	url := "https://api.paystack.co/bulkcharge"
+	respBody := &InitiateBulkChargeResp{}
+	if err := c.request(ctx, url, "POST", bulkChargeItems(charges), respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Lists the bulk charge batches created on the integration, optionally filtered by date.
+func (c *Client) ListBulkChargeBatches(ctx context.Context, opts *ListOptions) ([]*BulkChargeBatch, *Meta, error) {
+	type ListBulkChargeBatchesResp struct {
+		Data []*BulkChargeBatch `json:"data"`
+		Meta *Meta              `json:"meta"`
+	}
+	url := withQuery("https://api.paystack.co/bulkcharge", opts.values())
+	respBody := &ListBulkChargeBatchesResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the bulk charge batch with the given id or batch code, including how many of its charges are still pending.
+func (c *Client) FetchBulkChargeBatch(ctx context.Context, idOrCode string) (*BulkChargeBatch, error) {
+	type FetchBulkChargeBatchResp struct {
+		Data *BulkChargeBatch `json:"data"`
+	}
+	url := "https://api.paystack.co/bulkcharge/" + idOrCode
+	respBody := &FetchBulkChargeBatchResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type BulkCharge struct {
+	Id            int                  `json:"id"`
+	Amount        Amount               `json:"amount"`
+	Currency      string               `json:"currency"`
+	Status        string               `json:"status"`
+	Domain        string               `json:"domain"`
+	Customer      *Customer            `json:"customer"`
+	Authorization *Authorization       `json:"authorization"`
+	Transaction   *VerifiedTransaction `json:"transaction"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+}
+
+type ListBulkChargesOptions struct {
+	ListOptions
+	// Only include charges with this status: "pending", "success" or "failed".
+	Status string
+}
+
+// Lists the charges in the bulk charge batch with the given id or batch code, optionally filtered by status and date.
+func (c *Client) ListBulkCharges(ctx context.Context, idOrCode string, opts *ListBulkChargesOptions) ([]*BulkCharge, *Meta, error) {
+	type ListBulkChargesResp struct {
+		Data []*BulkCharge `json:"data"`
+		Meta *Meta         `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListBulkChargesOptions{}
+	}
+	q := opts.values()
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	url := withQuery("https://api.paystack.co/bulkcharge/"+idOrCode+"/charges", q)
+	respBody := &ListBulkChargesResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Pauses processing of the bulk charge batch with the given batch code.
+func (c *Client) PauseBulkChargeBatch(ctx context.Context, batchCode string) error {
+	url := "https://api.paystack.co/bulkcharge/pause/" + batchCode
+	return c.request(ctx, url, "GET", nil, nil)
+}
+
+// Resumes processing of a paused bulk charge batch.
+func (c *Client) ResumeBulkChargeBatch(ctx context.Context, batchCode string) error {
+	url := "https://api.paystack.co/bulkcharge/resume/" + batchCode
+	return c.request(ctx, url, "GET", nil, nil)
+}
@@ -0,0 +1,106 @@
+package paystack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Stores responses of stable GET endpoints: ResolveAccount, ResolveBin, ListBanks, ListCountries, ListStates and FetchPlan.
+// These are rate limited, rarely change and are usually called with the same inputs, e.g. on every checkout page load.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Returns the value stored under key, or false if it is missing or expired.
+	Get(key string) ([]byte, bool)
+	// Stores value under key until ttl has passed.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// Caches responses of stable GET endpoints in cache for ttl, using an in-memory cache if cache is nil. Nothing is cached by default.
+func WithCache(cache Cache, ttl time.Duration) Option {
+	if cache == nil {
+		cache = NewMemoryCache()
+	}
+	return func(c *Client) {
+		c.cache = cache
+		c.cacheTtl = ttl
+	}
+}
+
+// Makes a GET request, serving it from the client's cache if one is configured.
+// Responses are cached per secret key, so tenants selected with ContextWithKey never see each other's responses.
+// Dry runs bypass the cache so they always return a *DryRunError.
+func (c *Client) cachedRequest(ctx context.Context, url string, resp_body any) error {
+	if c.cache == nil || c.isDryRun(ctx) {
+		return c.request(ctx, url, "GET", nil, resp_body)
+	}
+	secret, err := c.secretFor(ctx)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256([]byte(secret))
+	key := hex.EncodeToString(sum[:8]) + " " + url
+	resBody, ok := c.cache.Get(key)
+	if !ok {
+		resBody, err = c.do(ctx, url, "GET", nil)
+		if err != nil {
+			return err
+		}
+		c.cache.Set(key, resBody, c.cacheTtl)
+	}
+	return json.Unmarshal(resBody, resp_body)
+}
+
+type memoryCacheEntry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+// How many entries an in-memory store holds before it first sweeps expired ones.
+const minSweepSize = 64
+
+// An in-memory Cache. Expired entries are removed when they are next read,
+// or by a sweep once the cache has doubled in size since the last one, so setting entries costs amortised constant time.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+	// The number of entries at which expired ones are next swept.
+	sweepAt int
+}
+
+// Creates an empty in-memory cache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: map[string]memoryCacheEntry{}, sweepAt: minSweepSize}
+}
+
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(m.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if len(m.entries) >= m.sweepAt {
+		for key, entry := range m.entries {
+			if now.After(entry.expiresAt) {
+				delete(m.entries, key)
+			}
+		}
+		m.sweepAt = max(2*len(m.entries), minSweepSize)
+	}
+	m.entries[key] = memoryCacheEntry{value: value, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,260 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// What a direct charge needs next, as reported in its status.
+type ChargeStatus string
+
+const (
+	ChargeSendPin      ChargeStatus = "send_pin"
+	ChargeSendOtp      ChargeStatus = "send_otp"
+	ChargeSendPhone    ChargeStatus = "send_phone"
+	ChargeSendBirthday ChargeStatus = "send_birthday"
+	ChargeSendAddress  ChargeStatus = "send_address"
+	ChargeOpenUrl      ChargeStatus = "open_url"
+	ChargePayOffline   ChargeStatus = "pay_offline"
+	ChargePending      ChargeStatus = "pending"
+	ChargeSuccess      ChargeStatus = "success"
+	ChargeFailed       ChargeStatus = "failed"
+)
+
+type ChargeBank struct {
+	Code          string `json:"code"`
+	AccountNumber string `json:"account_number"`
+}
+
+// The mobile money network a charge is made on.
+type MobileMoneyProvider string
+
+const (
+	MobileMoneyMpesa      MobileMoneyProvider = "mpesa"
+	MobileMoneyMtn        MobileMoneyProvider = "mtn"
+	MobileMoneyVodafone   MobileMoneyProvider = "vod"
+	MobileMoneyAirtelTigo MobileMoneyProvider = "atl"
+)
+
+// Reports whether p is one of the mobile money providers supported by Paystack.
+func (p MobileMoneyProvider) Valid() bool {
+	switch p {
+	case MobileMoneyMpesa, MobileMoneyMtn, MobileMoneyVodafone, MobileMoneyAirtelTigo:
+		return true
+	}
+	return false
+}
+
+type ChargeMobileMoney struct {
+	Phone    string              `json:"phone"`
+	Provider MobileMoneyProvider `json:"provider"`
+}
+
+// The bank USSD short code a charge is paid through.
+type UssdType string
+
+const (
+	UssdGtBank   UssdType = "737"
+	UssdUba      UssdType = "919"
+	UssdSterling UssdType = "822"
+	UssdZenith   UssdType = "966"
+)
+
+// Reports whether t is one of the USSD types supported by Paystack.
+func (t UssdType) Valid() bool {
+	switch t {
+	case UssdGtBank, UssdUba, UssdSterling, UssdZenith:
+		return true
+	}
+	return false
+}
+
+type ChargeUssd struct {
+	Type UssdType `json:"type"`
+}
+
+// The scheme a QR charge is paid through.
+type QrProvider string
+
+const (
+	QrVisa      QrProvider = "visa"
+	QrScanToPay QrProvider = "scan-to-pay"
+)
+
+// Reports whether p is one of the QR providers supported by Paystack.
+func (p QrProvider) Valid() bool {
+	return p == QrVisa || p == QrScanToPay
+}
+
+type ChargeQr struct {
+	Provider QrProvider `json:"provider"`
+}
+
+type ChargeBankTransfer struct {
+	// When the temporary account stops accepting the transfer. Paystack's default applies if zero.
+	AccountExpiresAt time.Time `json:"-"`
+}
+
+func (t *ChargeBankTransfer) MarshalJSON() ([]byte, error) {
+	type BankTransferReq struct {
+		AccountExpiresAt *time.Time `json:"account_expires_at,omitempty"`
+	}
+	reqBody := &BankTransferReq{}
+	if !t.AccountExpiresAt.IsZero() {
+		expires := t.AccountExpiresAt.UTC()
+		reqBody.AccountExpiresAt = &expires
+	}
+	return json.Marshal(reqBody)
+}
+
+type NewCharge struct {
+	Email string `json:"email"`
+	// Amount is in the smallest unit, e.g. kobo instead of NGN.
+	Amount            int64               `json:"amount"`
+	Currency          string              `json:"currency,omitempty"`
+	Reference         string              `json:"reference,omitempty"`
+	Bank              *ChargeBank         `json:"bank,omitempty"`
+	MobileMoney       *ChargeMobileMoney  `json:"mobile_money,omitempty"`
+	Ussd              *ChargeUssd         `json:"ussd,omitempty"`
+	Qr                *ChargeQr           `json:"qr,omitempty"`
+	BankTransfer      *ChargeBankTransfer `json:"bank_transfer,omitempty"`
+	AuthorizationCode string              `json:"authorization_code,omitempty"`
+	Pin               string              `json:"pin,omitempty"`
+	// Some banks require the account holder's birthday up front. Only the date is sent.
+	Birthday time.Time `json:"-"`
+	Metadata any       `json:"metadata,omitempty"`
+}
+
+type TransferBank struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+type Charge struct {
+	Id              int          `json:"id"`
+	Reference       string       `json:"reference"`
+	Status          ChargeStatus `json:"status"`
+	DisplayText     string       `json:"display_text"`
+	Message         string       `json:"message"`
+	Amount          Amount       `json:"amount"`
+	Currency        string       `json:"currency"`
+	Channel         string       `json:"channel"`
+	GatewayResponse string       `json:"gateway_response"`
+	Url             string       `json:"url"`
+	// The code the customer dials to complete a USSD charge.
+	UssdCode string `json:"ussd_code"`
+	// The code to render for the customer to scan to complete a QR charge. Url links to an image of it.
+	QrCode string `json:"qr_code"`
+	// The temporary account the customer pays a bank transfer charge into, and when it stops accepting the transfer.
+	AccountName      string         `json:"account_name"`
+	AccountNumber    string         `json:"account_number"`
+	Bank             *TransferBank  `json:"bank"`
+	AccountExpiresAt *time.Time     `json:"account_expires_at"`
+	Customer         *Customer      `json:"customer"`
+	Authorization    *Authorization `json:"authorization"`
+}
+
+type createChargeReq struct {
+	*NewCharge
+	Birthday string `json:"birthday,omitempty"`
+}
+
+// Initiates a direct charge. The returned status says what the charge needs next, e.g. a PIN, OTP or birthday, before it can succeed.
+func (c *Client) CreateCharge(ctx context.Context, charge *NewCharge) (*Charge, error) {
+	type CreateChargeResp struct {
+		Data *Charge `json:"data"`
+	}
+	url := "https://api.paystack.co/charge"
+	reqBody := &createChargeReq{NewCharge: charge}
+	if !charge.Birthday.IsZero() {
+		reqBody.Birthday = charge.Birthday.Format(time.DateOnly)
+	}
+	respBody := &CreateChargeResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+func (c *Client) submitCharge(ctx context.Context, url string, reqBody any) (*Charge, error) {
+	type SubmitChargeResp struct {
+		Data *Charge `json:"data"`
+	}
+	respBody := &SubmitChargeResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Submits the customer's card PIN for a charge with status "send_pin".
+func (c *Client) SubmitPin(ctx context.Context, ref string, pin string) (*Charge, error) {
+	type SubmitPinReq struct {
+		Pin       string `json:"pin"`
+		Reference string `json:"reference"`
+	}
+	url := "https://api.paystack.co/charge/submit_pin"
+	return c.submitCharge(ctx, url, &SubmitPinReq{Pin: pin, Reference: ref})
+}
+
+// Submits the OTP sent to the customer by their bank for a charge with status "send_otp".
+func (c *Client) SubmitOtp(ctx context.Context, ref string, otp string) (*Charge, error) {
+	type SubmitOtpReq struct {
+		Otp       string `json:"otp"`
+		Reference string `json:"reference"`
+	}
+	url := "https://api.paystack.co/charge/submit_otp"
+	return c.submitCharge(ctx, url, &SubmitOtpReq{Otp: otp, Reference: ref})
+}
+
+// Submits the customer's phone number for a charge with status "send_phone".
+func (c *Client) SubmitPhone(ctx context.Context, ref string, phone string) (*Charge, error) {
+	type SubmitPhoneReq struct {
+		Phone     string `json:"phone"`
+		Reference string `json:"reference"`
+	}
+	url := "https://api.paystack.co/charge/submit_phone"
+	return c.submitCharge(ctx, url, &SubmitPhoneReq{Phone: phone, Reference: ref})
+}
+
+// Submits the customer's birthday for a charge with status "send_birthday". Only the date part of birthday is sent.
+func (c *Client) SubmitBirthday(ctx context.Context, ref string, birthday time.Time) (*Charge, error) {
+	type SubmitBirthdayReq struct {
+		Birthday  string `json:"birthday"`
+		Reference string `json:"reference"`
+	}
+	url := "https://api.paystack.co/charge/submit_birthday"
+	return c.submitCharge(ctx, url, &SubmitBirthdayReq{Birthday: birthday.Format(time.DateOnly), Reference: ref})
+}
+
+type ChargeAddress struct {
+	Address string `json:"address"`
+	City    string `json:"city"`
+	State   string `json:"state"`
+	ZipCode string `json:"zipcode"`
+}
+
+// Submits the card holder's billing address for a charge with status "send_address".
+func (c *Client) SubmitAddress(ctx context.Context, ref string, address *ChargeAddress) (*Charge, error) {
+	type SubmitAddressReq struct {
+		*ChargeAddress
+		Reference string `json:"reference"`
+	}
+	url := "https://api.paystack.co/charge/submit_address"
+	return c.submitCharge(ctx, url, &SubmitAddressReq{ChargeAddress: address, Reference: ref})
+}
+
+// Checks the status of a pending charge. Paystack recommends waiting 10 seconds after a charge reports "pending" before checking.
+func (c *Client) CheckPendingCharge(ctx context.Context, ref string) (*Charge, error) {
+	type CheckPendingChargeResp struct {
+		Data *Charge `json:"data"`
+	}
+	url := "https://api.paystack.co/charge/" + ref
+	respBody := &CheckPendingChargeResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
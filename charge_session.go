@@ -0,0 +1,141 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// What the caller of a direct charge must do next.
+type NextAction string
+
+const (
+	// Ask the customer for their card PIN and pass it to ChargeSession.SubmitPin.
+	ActionSendPin NextAction = "send_pin"
+	// Ask the customer for the OTP sent by their bank and pass it to ChargeSession.SubmitOtp.
+	ActionSendOtp NextAction = "send_otp"
+	// Ask the customer for their phone number and pass it to ChargeSession.SubmitPhone.
+	ActionSendPhone NextAction = "send_phone"
+	// Ask the customer for their birthday and pass it to ChargeSession.SubmitBirthday.
+	ActionSendBirthday NextAction = "send_birthday"
+	// Ask the customer for their billing address and pass it to ChargeSession.SubmitAddress.
+	ActionSendAddress NextAction = "send_address"
+	// Send the customer to ChargeSession.Charge.Url to authorize the charge.
+	ActionOpenUrl NextAction = "open_url"
+	// Show the customer the display text, e.g. USSD or transfer instructions, then poll.
+	ActionPayOffline NextAction = "pay_offline"
+	// Nothing is needed from the customer. Call ChargeSession.Poll until the charge completes.
+	ActionWait NextAction = "wait"
+	// The charge succeeded.
+	ActionDone NextAction = "done"
+	// The charge failed. The display text or gateway response says why.
+	ActionFailed NextAction = "failed"
+	// Paystack returned a status this package does not know about.
+	ActionUnknown NextAction = "unknown"
+)
+
+// Drives a multi-step direct charge, keeping track of the latest charge response.
+type ChargeSession struct {
+	client *Client
+	// The latest response from Paystack for this charge.
+	Charge *Charge
+}
+
+// Creates the charge and returns a session for completing it.
+func (c *Client) StartCharge(ctx context.Context, charge *NewCharge) (*ChargeSession, error) {
+	created, err := c.CreateCharge(ctx, charge)
+	if err != nil {
+		return nil, err
+	}
+	return &ChargeSession{client: c, Charge: created}, nil
+}
+
+// Returns what must happen next for the charge to complete.
+func (s *ChargeSession) NextAction() NextAction {
+	switch s.Charge.Status {
+	case ChargeSendPin:
+		return ActionSendPin
+	case ChargeSendOtp:
+		return ActionSendOtp
+	case ChargeSendPhone:
+		return ActionSendPhone
+	case ChargeSendBirthday:
+		return ActionSendBirthday
+	case ChargeSendAddress:
+		return ActionSendAddress
+	case ChargeOpenUrl:
+		return ActionOpenUrl
+	case ChargePayOffline:
+		return ActionPayOffline
+	case ChargePending:
+		return ActionWait
+	case ChargeSuccess:
+		return ActionDone
+	case ChargeFailed:
+		return ActionFailed
+	}
+	return ActionUnknown
+}
+
+// Returns the text Paystack suggests showing the customer for the next action.
+func (s *ChargeSession) DisplayText() string {
+	if s.Charge.DisplayText != "" {
+		return s.Charge.DisplayText
+	}
+	if s.Charge.Message != "" {
+		return s.Charge.Message
+	}
+	return s.Charge.GatewayResponse
+}
+
+func (s *ChargeSession) advance(expected NextAction, submit func(ref string) (*Charge, error)) error {
+	if action := s.NextAction(); action != expected {
+		return fmt.Errorf("charge %s expects %s, not %s", s.Charge.Reference, action, expected)
+	}
+	charge, err := submit(s.Charge.Reference)
+	if err != nil {
+		return err
+	}
+	s.Charge = charge
+	return nil
+}
+
+// Submits the customer's PIN and updates the session with the response.
+func (s *ChargeSession) SubmitPin(ctx context.Context, pin string) error {
+	return s.advance(ActionSendPin, func(ref string) (*Charge, error) { return s.client.SubmitPin(ctx, ref, pin) })
+}
+
+// Submits the OTP and updates the session with the response.
+func (s *ChargeSession) SubmitOtp(ctx context.Context, otp string) error {
+	return s.advance(ActionSendOtp, func(ref string) (*Charge, error) { return s.client.SubmitOtp(ctx, ref, otp) })
+}
+
+// Submits the customer's phone number and updates the session with the response.
+func (s *ChargeSession) SubmitPhone(ctx context.Context, phone string) error {
+	return s.advance(ActionSendPhone, func(ref string) (*Charge, error) { return s.client.SubmitPhone(ctx, ref, phone) })
+}
+
+// Submits the customer's birthday and updates the session with the response.
+func (s *ChargeSession) SubmitBirthday(ctx context.Context, birthday time.Time) error {
+	return s.advance(ActionSendBirthday, func(ref string) (*Charge, error) { return s.client.SubmitBirthday(ctx, ref, birthday) })
+}
+
+// Submits the card holder's billing address and updates the session with the response.
+func (s *ChargeSession) SubmitAddress(ctx context.Context, address *ChargeAddress) error {
+	return s.advance(ActionSendAddress, func(ref string) (*Charge, error) { return s.client.SubmitAddress(ctx, ref, address) })
+}
+
+// Waits 10 seconds, as recommended by Paystack, then checks the charge and updates the session with its status.
+func (s *ChargeSession) Poll(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(10 * time.Second):
+	}
+	charge, err := s.client.CheckPendingCharge(ctx, s.Charge.Reference)
+	if err != nil {
+		return err
+	}
+	s.Charge = charge
+	return nil
+}
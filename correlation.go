@@ -0,0 +1,41 @@
+package paystack
+
+import (
+	"context"
+	"log/slog"
+)
+
+type correlationIdKey struct{}
+
+// Returns a copy of ctx carrying the given correlation id, for clients configured with WithCorrelationId to send to Paystack.
+func ContextWithCorrelationId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIdKey{}, id)
+}
+
+// Returns the correlation id stored in ctx by ContextWithCorrelationId, or an empty string.
+func CorrelationIdFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIdKey{}).(string)
+	return id
+}
+
+// Sends the correlation id returned by extract for each call's context in the given header, and includes it in debug logs.
+// Header defaults to "X-Request-Id" and extract to CorrelationIdFromContext. No header is sent when the id is empty.
+func WithCorrelationId(header string, extract func(ctx context.Context) string) Option {
+	if header == "" {
+		header = "X-Request-Id"
+	}
+	if extract == nil {
+		extract = CorrelationIdFromContext
+	}
+	return func(c *Client) {
+		c.correlationHeader = header
+		c.correlationId = extract
+	}
+}
+
+// Logs every request made to Paystack at debug level. The secret key, query strings and bodies are never logged.
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
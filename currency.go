@@ -0,0 +1,101 @@
+package paystack
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type currencyInfo struct {
+	// How many of the smallest unit make up one of the major unit, e.g. 100 kobo per naira.
+	factor int64
+	// What amounts are prefixed with when displayed, including any space between it and the number.
+	symbol string
+}
+
+// The currencies Paystack settles in.
+var currencies = map[string]currencyInfo{
+	"NGN": {factor: 100, symbol: "₦"},
+	"GHS": {factor: 100, symbol: "GH₵"},
+	"ZAR": {factor: 100, symbol: "R "},
+	"KES": {factor: 100, symbol: "KSh "},
+	"EGP": {factor: 100, symbol: "E£"},
+	"USD": {factor: 100, symbol: "$"},
+	"XOF": {factor: 1, symbol: "CFA "},
+	"RWF": {factor: 1, symbol: "FRw "},
+}
+
+func lookupCurrency(currency string) (currencyInfo, error) {
+	info, ok := currencies[strings.ToUpper(currency)]
+	if !ok {
+		return currencyInfo{}, fmt.Errorf("unsupported currency %q", currency)
+	}
+	return info, nil
+}
+
+// Converts an amount in the major unit of the currency, e.g. 15.5 naira, to the smallest unit Paystack expects, e.g. 1550 kobo.
+// The decimal value of major is converted exactly and rounded half away from zero, so 19.99 becomes 1999 rather than 1998.
+func MajorToMinor(major float64, currency string) (Amount, error) {
+	info, err := lookupCurrency(currency)
+	if err != nil {
+		return 0, err
+	}
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(major, 'f', -1, 64))
+	if !ok {
+		return 0, fmt.Errorf("invalid amount %v", major)
+	}
+	r.Mul(r, new(big.Rat).SetInt64(info.factor))
+	// Round half away from zero: truncate |r| + 1/2.
+	neg := r.Sign() < 0
+	r.Abs(r)
+	r.Add(r, big.NewRat(1, 2))
+	n := new(big.Int).Quo(r.Num(), r.Denom())
+	if neg {
+		n.Neg(n)
+	}
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("amount %v %s overflows int64", major, currency)
+	}
+	return Amount(n.Int64()), nil
+}
+
+// Converts an amount in the smallest unit of the currency, e.g. 1550 kobo, to its major unit, e.g. 15.5 naira.
+func MinorToMajor(minor Amount, currency string) (float64, error) {
+	info, err := lookupCurrency(currency)
+	if err != nil {
+		return 0, err
+	}
+	f, _ := new(big.Rat).SetFrac64(int64(minor), info.factor).Float64()
+	return f, nil
+}
+
+// Formats an amount in the smallest unit of the currency for display, e.g. 150000 NGN as "₦1,500.00" and 2500 ZAR as "R 25.00".
+// The major unit is grouped in thousands and followed by as many decimals as the currency has.
+func FormatAmount(amount Amount, currency string) (string, error) {
+	info, err := lookupCurrency(currency)
+	if err != nil {
+		return "", err
+	}
+	sign := ""
+	n := new(big.Int).SetInt64(int64(amount))
+	if n.Sign() < 0 {
+		sign = "-"
+		n.Neg(n)
+	}
+	major, minor := new(big.Int).QuoRem(n, big.NewInt(info.factor), new(big.Int))
+	digits := major.String()
+	var b strings.Builder
+	b.WriteString(sign)
+	b.WriteString(info.symbol)
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(d)
+	}
+	if decimals := len(strconv.FormatInt(info.factor, 10)) - 1; decimals > 0 {
+		fmt.Fprintf(&b, ".%0*d", decimals, minor.Int64())
+	}
+	return b.String(), nil
+}
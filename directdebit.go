@@ -0,0 +1,156 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type MandateAccount struct {
+	Number   string `json:"number"`
+	BankCode string `json:"bank_code"`
+}
+
+type MandateAddress struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+	State  string `json:"state"`
+}
+
+type NewMandate struct {
+	Email       string `json:"email"`
+	CallbackUrl string `json:"callback_url,omitempty"`
+	// The bank account to debit. The customer picks one on the redirect page if nil.
+	Account *MandateAccount `json:"account,omitempty"`
+	// The account holder's address, required by the bank when Account is set.
+	Address *MandateAddress `json:"address,omitempty"`
+}
+
+type InitializedMandate struct {
+	// Where the customer is sent to authorize the mandate with their bank.
+	RedirectUrl string `json:"redirect_url"`
+	AccessCode  string `json:"access_code"`
+	Reference   string `json:"reference"`
+}
+
+// Starts a direct debit mandate authorization for a customer. Send the customer to the returned redirect url to approve the mandate with their bank,
+// then verify it with VerifyMandate. Returns a *ValidationError without calling Paystack if an account is given without its bank code or address.
+func (c *Client) InitializeMandate(ctx context.Context, mandate *NewMandate) (*InitializedMandate, error) {
+	type InitializeMandateReq struct {
+		*NewMandate
+		Channel string `json:"channel"`
+	}
+	type InitializeMandateResp struct {
+		Data *InitializedMandate `json:"data"`
+	}
+	url := "https://api.paystack.co/customer/authorization/initialize"
+	reqBody := &InitializeMandateReq{NewMandate: mandate, Channel: "direct_debit"}
+	respBody := &InitializeMandateResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type MandateCustomer struct {
+	Id    int    `json:"id"`
+	Code  string `json:"customer_code"`
+	Email string `json:"email"`
+}
+
+type VerifiedMandate struct {
+	// The code to charge the mandate with once it is active.
+	AuthorizationCode string           `json:"authorization_code"`
+	Channel           string           `json:"channel"`
+	Bank              string           `json:"bank"`
+	Active            bool             `json:"active"`
+	Customer          *MandateCustomer `json:"customer"`
+}
+
+// Checks whether the customer approved the mandate authorization with the given reference. Charges can only be made once the mandate is active.
+func (c *Client) VerifyMandate(ctx context.Context, ref string) (*VerifiedMandate, error) {
+	type VerifyMandateResp struct {
+		Data *VerifiedMandate `json:"data"`
+	}
+	url := "https://api.paystack.co/customer/authorization/verify/" + ref
+	respBody := &VerifyMandateResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type Mandate struct {
+	Id                int              `json:"id"`
+	Status            string           `json:"status"`
+	MandateId         int              `json:"mandate_id"`
+	AuthorizationId   int              `json:"authorization_id"`
+	AuthorizationCode string           `json:"authorization_code"`
+	IntegrationId     int              `json:"integration_id"`
+	AccountNumber     string           `json:"account_number"`
+	BankCode          string           `json:"bank_code"`
+	BankName          string           `json:"bank_name"`
+	Customer          *MandateCustomer `json:"customer"`
+	AuthorizedAt      *time.Time       `json:"authorized_at"`
+}
+
+type ListMandatesOptions struct {
+	PerPage int
+	// The Next cursor of the previous page.
+	Cursor string
+	// Only include mandates with this status, e.g. "active", "pending" or "revoked".
+	Status string
+}
+
+// Lists the direct debit mandate authorizations of the customer with the given id.
+func (c *Client) ListMandates(ctx context.Context, customerId int, opts *ListMandatesOptions) ([]*Mandate, *CursorMeta, error) {
+	type ListMandatesResp struct {
+		Data []*Mandate  `json:"data"`
+		Meta *CursorMeta `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListMandatesOptions{}
+	}
+	q := url.Values{}
+	if opts.PerPage > 0 {
+		q.Set("per_page", strconv.Itoa(opts.PerPage))
+	}
+	if opts.Cursor != "" {
+		q.Set("cursor", opts.Cursor)
+	}
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	url := withQuery("https://api.paystack.co/customer/"+strconv.Itoa(customerId)+"/directdebit-mandate-authorizations", q)
+	respBody := &ListMandatesResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Charges the customer with the given email against their active mandate's authorization code.
+// Direct debits settle asynchronously, so the returned status is usually "pending"; track the reference with a QueuedChargeTracker or VerifyTransaction.
+func (c *Client) ChargeMandate(ctx context.Context, email string, amount int32, authCode string) (*VerifiedTransaction, error) {
+	type ChargeMandateResp struct {
+		Data *VerifiedTransaction `json:"data"`
+	}
+	url := "https://api.paystack.co/transaction/charge_authorization"
+	reqBody := &chargeAuthorizationReq{Email: email, Amount: fmt.Sprintf("%d", amount), AuthorizationCode: authCode, amount: int64(amount)}
+	respBody := &ChargeMandateResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Deactivates the mandate with the given authorization code, so it can no longer be charged.
+func (c *Client) DeactivateMandate(ctx context.Context, authCode string) error {
+	type DeactivateMandateReq struct {
+		AuthorizationCode string `json:"authorization_code"`
+	}
+	url := "https://api.paystack.co/customer/authorization/deactivate"
+	return c.request(ctx, url, "POST", &DeactivateMandateReq{AuthorizationCode: authCode}, nil)
+}
@@ -0,0 +1,63 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+type DisputeExport struct {
+	// A temporary url the exported file can be downloaded from.
+	Path      string    `json:"path"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type ExportDisputesOptions struct {
+	ListOptions
+	// Only include disputes with this status, e.g. "resolved".
+	Status string
+}
+
+// Exports the disputes raised against the integration to a file, optionally filtered by status and date.
+func (c *Client) ExportDisputes(ctx context.Context, opts *ExportDisputesOptions) (*DisputeExport, error) {
+	type ExportDisputesResp struct {
+		Data *DisputeExport `json:"data"`
+	}
+	if opts == nil {
+		opts = &ExportDisputesOptions{}
+	}
+	q := opts.values()
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	url := withQuery("https://api.paystack.co/dispute/export", q)
+	respBody := &ExportDisputesResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Exports the disputes matching opts and writes the exported file to w.
+func (c *Client) DownloadDisputeExport(ctx context.Context, opts *ExportDisputesOptions, w io.Writer) error {
+	export, err := c.ExportDisputes(ctx, opts)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", export.Path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("downloading dispute export: %s", resp.Status)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
@@ -0,0 +1,191 @@
+package paystack
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+type DisputeEvidence struct {
+	Id              int        `json:"id"`
+	CustomerEmail   string     `json:"customer_email"`
+	CustomerName    string     `json:"customer_name"`
+	CustomerPhone   string     `json:"customer_phone"`
+	ServiceDetails  string     `json:"service_details"`
+	DeliveryAddress string     `json:"delivery_address"`
+	DeliveryDate    *time.Time `json:"delivery_date"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type DisputeHistory struct {
+	Status    string    `json:"status"`
+	By        string    `json:"by"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type DisputeMessage struct {
+	Sender    string    `json:"sender"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type Dispute struct {
+	Id                   int                  `json:"id"`
+	Status               string               `json:"status"`
+	Category             string               `json:"category"`
+	Resolution           string               `json:"resolution"`
+	RefundAmount         Amount               `json:"refund_amount"`
+	Currency             string               `json:"currency"`
+	Domain               string               `json:"domain"`
+	TransactionReference string               `json:"transaction_reference"`
+	Transaction          *VerifiedTransaction `json:"transaction"`
+	Customer             *Customer            `json:"customer"`
+	Bin                  string               `json:"bin"`
+	Last4                string               `json:"last4"`
+	Evidence             *DisputeEvidence     `json:"evidence"`
+	History              []*DisputeHistory    `json:"history"`
+	Messages             []*DisputeMessage    `json:"messages"`
+	DueAt                *time.Time           `json:"dueAt"`
+	ResolvedAt           *time.Time           `json:"resolvedAt"`
+	CreatedAt            time.Time            `json:"createdAt"`
+	UpdatedAt            time.Time            `json:"updatedAt"`
+}
+
+type ListDisputesOptions struct {
+	ListOptions
+	// Only include disputes with this status, e.g. "awaiting-merchant-feedback" or "resolved".
+	Status string
+	// Only include disputes on the transaction with this id.
+	TransactionId int
+}
+
+// Lists the disputes raised against the integration, optionally filtered by status, transaction and date.
+func (c *Client) ListDisputes(ctx context.Context, opts *ListDisputesOptions) ([]*Dispute, *Meta, error) {
+	type ListDisputesResp struct {
+		Data []*Dispute `json:"data"`
+		Meta *Meta      `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListDisputesOptions{}
+	}
+	q := opts.values()
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	if opts.TransactionId != 0 {
+		q.Set("transaction", strconv.Itoa(opts.TransactionId))
+	}
+	url := withQuery("https://api.paystack.co/dispute", q)
+	respBody := &ListDisputesResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the dispute with the given id, including its evidence, messages and status history.
+func (c *Client) FetchDispute(ctx context.Context, id int) (*Dispute, error) {
+	type FetchDisputeResp struct {
+		Data *Dispute `json:"data"`
+	}
+	url := "https://api.paystack.co/dispute/" + strconv.Itoa(id)
+	respBody := &FetchDisputeResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Fetches the dispute raised against the transaction with the given id.
+func (c *Client) FetchTransactionDispute(ctx context.Context, transactionId int) (*Dispute, error) {
+	type FetchTransactionDisputeResp struct {
+		Data *Dispute `json:"data"`
+	}
+	url := "https://api.paystack.co/dispute/transaction/" + strconv.Itoa(transactionId)
+	respBody := &FetchTransactionDisputeResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Updates the refund amount and evidence file of the dispute with the given id. Required before resolving a dispute as merchant-accepted.
+// RefundAmount is in the smallest unit, e.g. kobo instead of NGN.
+func (c *Client) UpdateDispute(ctx context.Context, id int, refundAmount int64, uploadedFilename string) (*Dispute, error) {
+	type UpdateDisputeReq struct {
+		RefundAmount     int64  `json:"refund_amount"`
+		UploadedFilename string `json:"uploaded_filename,omitempty"`
+	}
+	type UpdateDisputeResp struct {
+		Data *Dispute `json:"data"`
+	}
+	url := "https://api.paystack.co/dispute/" + strconv.Itoa(id)
+	reqBody := &UpdateDisputeReq{RefundAmount: refundAmount, UploadedFilename: uploadedFilename}
+	respBody := &UpdateDisputeResp{}
+	if err := c.request(ctx, url, "PUT", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type NewDisputeEvidence struct {
+	CustomerEmail   string `json:"customer_email"`
+	CustomerName    string `json:"customer_name"`
+	CustomerPhone   string `json:"customer_phone"`
+	ServiceDetails  string `json:"service_details"`
+	DeliveryAddress string `json:"delivery_address,omitempty"`
+	// Only the date is sent.
+	DeliveryDate time.Time `json:"-"`
+}
+
+// Attaches evidence, e.g. proof of delivery, to the dispute with the given id.
+func (c *Client) AddDisputeEvidence(ctx context.Context, id int, evidence *NewDisputeEvidence) (*DisputeEvidence, error) {
+	type AddDisputeEvidenceReq struct {
+		*NewDisputeEvidence
+		DeliveryDate string `json:"delivery_date,omitempty"`
+	}
+	type AddDisputeEvidenceResp struct {
+		Data *DisputeEvidence `json:"data"`
+	}
+	url := "https://api.paystack.co/dispute/" + strconv.Itoa(id) + "/evidence"
+	reqBody := &AddDisputeEvidenceReq{NewDisputeEvidence: evidence}
+	if !evidence.DeliveryDate.IsZero() {
+		reqBody.DeliveryDate = evidence.DeliveryDate.Format(time.DateOnly)
+	}
+	respBody := &AddDisputeEvidenceResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// How the merchant resolves a dispute.
+type Resolution string
+
+const (
+	ResolutionMerchantAccepted Resolution = "merchant-accepted"
+	ResolutionDeclined         Resolution = "declined"
+)
+
+type DisputeResolution struct {
+	Resolution Resolution `json:"resolution"`
+	Message    string     `json:"message"`
+	// RefundAmount is in the smallest unit, e.g. kobo instead of NGN.
+	RefundAmount     int64  `json:"refund_amount"`
+	UploadedFilename string `json:"uploaded_filename"`
+	// The id of evidence previously added with AddDisputeEvidence. Required when declining.
+	EvidenceId int `json:"evidence,omitempty"`
+}
+
+// Resolves the dispute with the given id, either accepting liability or declining it with evidence.
+func (c *Client) ResolveDispute(ctx context.Context, id int, resolution *DisputeResolution) (*Dispute, error) {
+	type ResolveDisputeResp struct {
+		Data *Dispute `json:"data"`
+	}
+	url := "https://api.paystack.co/dispute/" + strconv.Itoa(id) + "/resolve"
+	respBody := &ResolveDisputeResp{}
+	if err := c.request(ctx, url, "PUT", resolution, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
@@ -0,0 +1,37 @@
+package paystack
+
+import (
+	"context"
+	"net/http"
+)
+
+type dryRunKey struct{}
+
+// Validates and builds requests without sending them. Every call returns a *DryRunError holding the request that would have been sent.
+func WithDryRun() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// Returns a copy of ctx that makes calls validate and build their request without sending it, as if the client was created WithDryRun.
+func ContextWithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, dryRunKey{}, true)
+}
+
+func (c *Client) isDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(dryRunKey{}).(bool)
+	return c.dryRun || dryRun
+}
+
+// Returned in dry run mode once a request passed validation, instead of sending it.
+// Request carries the secret key in its Authorization header, so avoid logging it as is.
+type DryRunError struct {
+	Request *http.Request
+	// The JSON body of Request.
+	Body []byte
+}
+
+func (e *DryRunError) Error() string {
+	return "paystack: dry run: " + e.Request.Method + " " + path(e.Request.URL.String())
+}
@@ -0,0 +1,60 @@
+package paystack
+
+// Who pays the Paystack fees on a split payment.
+type Bearer string
+
+const (
+	BearerAccount         Bearer = "account"
+	BearerSubaccount      Bearer = "subaccount"
+	BearerAll             Bearer = "all"
+	BearerAllProportional Bearer = "all-proportional"
+)
+
+// Reports whether b is one of the bearers supported by Paystack.
+func (b Bearer) Valid() bool {
+	switch b {
+	case BearerAccount, BearerSubaccount, BearerAll, BearerAllProportional:
+		return true
+	}
+	return false
+}
+
+// How Paystack's fraud checks treat a customer.
+type RiskAction string
+
+const (
+	// Apply the integration's usual fraud checks.
+	RiskActionDefault RiskAction = "default"
+	// Whitelist the customer.
+	RiskActionAllow RiskAction = "allow"
+	// Blacklist the customer, declining all their transactions.
+	RiskActionDeny RiskAction = "deny"
+)
+
+// Reports whether a is one of the risk actions supported by Paystack.
+func (a RiskAction) Valid() bool {
+	switch a {
+	case RiskActionDefault, RiskActionAllow, RiskActionDeny:
+		return true
+	}
+	return false
+}
+
+// How often a subaccount's share of payments is settled.
+type SettlementSchedule string
+
+const (
+	SettlementAuto    SettlementSchedule = "auto"
+	SettlementWeekly  SettlementSchedule = "weekly"
+	SettlementMonthly SettlementSchedule = "monthly"
+	SettlementManual  SettlementSchedule = "manual"
+)
+
+// Reports whether s is one of the settlement schedules supported by Paystack.
+func (s SettlementSchedule) Valid() bool {
+	switch s {
+	case SettlementAuto, SettlementWeekly, SettlementMonthly, SettlementManual:
+		return true
+	}
+	return false
+}
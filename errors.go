@@ -0,0 +1,171 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// An error response from the Paystack API.
+type APIError struct {
+	StatusCode int
+	// Paystack's explanation of the error.
+	Message string
+	// The category of the error, e.g. "validation_error", "processor_error" or "api_error", when Paystack provides one.
+	Type string
+	// A machine readable error code, e.g. "insufficient_balance", when Paystack provides one.
+	Code string
+	// Messages about individual request fields, keyed by field name, when Paystack rejects a request with per-field errors.
+	Fields map[string]string
+	// The raw response body.
+	Body []byte
+}
+
+func newAPIError(statusCode int, body []byte) *APIError {
+	type ErrorResp struct {
+		Message string          `json:"message"`
+		Type    string          `json:"type"`
+		Code    string          `json:"code"`
+		Errors  json.RawMessage `json:"errors"`
+		Meta    struct {
+			Errors json.RawMessage `json:"errors"`
+		} `json:"meta"`
+	}
+	apiErr := &APIError{StatusCode: statusCode, Body: body}
+	errResp := &ErrorResp{}
+	if err := json.Unmarshal(body, errResp); err == nil {
+		apiErr.Message = errResp.Message
+		apiErr.Type = errResp.Type
+		apiErr.Code = errResp.Code
+		apiErr.Fields = fieldErrors(errResp.Meta.Errors)
+		if apiErr.Fields == nil {
+			apiErr.Fields = fieldErrors(errResp.Errors)
+		}
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = string(body)
+	}
+	return apiErr
+}
+
+// Parses per-field error messages, which Paystack sends either as an object keyed by field name or as a list of objects naming their field.
+// A field's message can be a string, an object with a message, or a list of either, in which case the first message is kept.
+// Returns nil if raw holds no field errors.
+func fieldErrors(raw json.RawMessage) map[string]string {
+	type FieldMessage struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+	fields := map[string]string{}
+	byField := map[string]json.RawMessage{}
+	list := []*FieldMessage{}
+	if err := json.Unmarshal(raw, &byField); err == nil {
+		for field, value := range byField {
+			if message := errorMessage(value); message != "" {
+				fields[field] = message
+			}
+		}
+	} else if err := json.Unmarshal(raw, &list); err == nil {
+		for _, item := range list {
+			if item != nil && item.Field != "" && item.Message != "" {
+				if _, ok := fields[item.Field]; !ok {
+					fields[item.Field] = item.Message
+				}
+			}
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// Returns the message in raw, which is a string, an object with a message, or a list of either.
+func errorMessage(raw json.RawMessage) string {
+	message := ""
+	if err := json.Unmarshal(raw, &message); err == nil {
+		return message
+	}
+	object := &struct {
+		Message string `json:"message"`
+	}{}
+	if err := json.Unmarshal(raw, object); err == nil {
+		return object.Message
+	}
+	list := []json.RawMessage{}
+	if err := json.Unmarshal(raw, &list); err == nil {
+		for _, item := range list {
+			if message := errorMessage(item); message != "" {
+				return message
+			}
+		}
+	}
+	return ""
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("paystack: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// Reports whether err is likely temporary, i.e. a network error, rate limiting or a server error, so the same request could succeed later.
+// Cancelled or expired contexts are not retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	// Every *url.Error is a net.Error, including TLS failures, bad URLs and too many redirects, so only its cause is considered.
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		err = urlErr.Err
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTemporary
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// Reports whether err says the integration's balance or the customer's account has insufficient funds.
+func IsInsufficientFunds(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == "insufficient_balance" || apiErr.Code == "insufficient_funds" {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return strings.Contains(message, "insufficient") && (strings.Contains(message, "balance") || strings.Contains(message, "funds"))
+}
+
+// Reports whether err says the reference sent to Paystack was already used.
+func IsDuplicateReference(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == "duplicate_reference" {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return strings.Contains(message, "duplicate") && strings.Contains(message, "reference")
+}
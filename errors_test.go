@@ -0,0 +1,44 @@
+package paystack
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestIsRetryable(t *testing.T) {
+	transportErr := func(err error) error {
+		return fmt.Errorf("paystack: GET /bank: %w", &url.Error{Op: "Get", URL: "https://api.paystack.co/bank", Err: err})
+	}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "rate limited", err: &APIError{StatusCode: 429}, want: true},
+		{name: "server error", err: &APIError{StatusCode: 502}, want: true},
+		{name: "bad request", err: &APIError{StatusCode: 400}},
+		{name: "connection reset", err: transportErr(&net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}), want: true},
+		{name: "dial failure", err: transportErr(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.EHOSTUNREACH)}), want: true},
+		{name: "unexpected eof", err: transportErr(io.ErrUnexpectedEOF), want: true},
+		{name: "temporary dns failure", err: transportErr(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Name: "api.paystack.co", IsTemporary: true}}), want: true},
+		{name: "unknown host", err: transportErr(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Name: "api.paystack.co", IsNotFound: true}})},
+		{name: "unknown certificate authority", err: transportErr(x509.UnknownAuthorityError{})},
+		{name: "too many redirects", err: transportErr(fmt.Errorf("stopped after 10 redirects"))},
+		{name: "cancelled", err: transportErr(context.Canceled)},
+		{name: "nil"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
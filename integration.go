@@ -0,0 +1,70 @@
+package paystack
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Whether a secret key moves real money.
+type KeyMode string
+
+const (
+	KeyModeTest KeyMode = "test"
+	KeyModeLive KeyMode = "live"
+	// The key has neither the sk_test_ nor the sk_live_ prefix.
+	KeyModeUnknown KeyMode = "unknown"
+)
+
+func keyMode(secret string) KeyMode {
+	switch {
+	case strings.HasPrefix(secret, "sk_test_"):
+		return KeyModeTest
+	case strings.HasPrefix(secret, "sk_live_"):
+		return KeyModeLive
+	}
+	return KeyModeUnknown
+}
+
+// The Paystack integration, i.e. business account, a secret key belongs to.
+type Integration struct {
+	Id           int    `json:"id"`
+	BusinessName string `json:"business_name"`
+	// Derived from the secret key's prefix rather than returned by Paystack.
+	Mode KeyMode `json:"-"`
+}
+
+// Returns how long, in seconds, a checkout session stays open before it times out. Zero means sessions never time out.
+func (c *Client) FetchPaymentSessionTimeout(ctx context.Context) (int, error) {
+	type FetchPaymentSessionTimeoutResp struct {
+		Data struct {
+			PaymentSessionTimeout int `json:"payment_session_timeout"`
+		} `json:"data"`
+	}
+	url := "https://api.paystack.co/integration/payment_session_timeout"
+	respBody := &FetchPaymentSessionTimeoutResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return 0, err
+	}
+	return respBody.Data.PaymentSessionTimeout, nil
+}
+
+// Sets how long, in seconds, a checkout session stays open before it times out. Zero disables the timeout.
+func (c *Client) UpdatePaymentSessionTimeout(ctx context.Context, timeout int) error {
+	type UpdatePaymentSessionTimeoutReq struct {
+		Timeout int `json:"timeout"`
+	}
+	url := "https://api.paystack.co/integration/payment_session_timeout"
+	reqBody := &UpdatePaymentSessionTimeoutReq{Timeout: timeout}
+	return c.request(ctx, url, "PUT", reqBody, nil)
+}
+
+// Makes a lightweight authenticated request to Paystack and returns how long it took, for readiness probes and dependency dashboards.
+// The latency includes any retries made by the client's retry policy.
+func (c *Client) Ping(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	if _, err := c.FetchPaymentSessionTimeout(ctx); err != nil {
+		return 0, err
+	}
+	return time.Since(start), nil
+}
@@ -0,0 +1,552 @@
+// Command openapigen generates Go types and endpoint stubs from Paystack's OpenAPI definition.
+//
+// It reads the definition as YAML, as Paystack publishes it, or JSON and writes a single gofmt'ed Go file:
+//
+//	go run ./internal/openapigen -spec openapi/paystack.json -out openapi/zz_generated.go -package openapi
+//
+// Properties can be given a hand-written type with -type, e.g. -type bearer_type=paystack.Bearer, importing its package with -import paystack=github.com/acudac-com/paystack-go.
+// Schemas that already have a hand-written type can be declared as an alias of it with -schema, e.g. -schema Plan=paystack.Plan.
+//
+// Every schema under components/schemas becomes a struct, and every operation becomes a function calling the endpoint through a Doer such as *paystack.Client
+// and decoding its successful response into the struct generated for the response's schema.
+// Required properties are always sent, while optional booleans and numbers are pointers so false and zero can be sent too.
+// Generated code is a starting point for hand-written wrappers, not a replacement for them.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+type schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 any                `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *schema            `json:"items"`
+	AllOf                []*schema          `json:"allOf"`
+	OneOf                []*schema          `json:"oneOf"`
+	AnyOf                []*schema          `json:"anyOf"`
+	AdditionalProperties any                `json:"additionalProperties"`
+}
+
+type parameter struct {
+	Ref      string  `json:"$ref"`
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *schema `json:"schema"`
+}
+
+type mediaType struct {
+	Schema *schema `json:"schema"`
+}
+
+type requestBody struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*mediaType `json:"content"`
+}
+
+type response struct {
+	Ref     string                `json:"$ref"`
+	Content map[string]*mediaType `json:"content"`
+}
+
+type operation struct {
+	OperationId string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Parameters  []*parameter         `json:"parameters"`
+	RequestBody *requestBody         `json:"requestBody"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type spec struct {
+	Paths      map[string]map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas       map[string]*schema      `json:"schemas"`
+		Parameters    map[string]*parameter   `json:"parameters"`
+		RequestBodies map[string]*requestBody `json:"requestBodies"`
+		Responses     map[string]*response    `json:"responses"`
+	} `json:"components"`
+}
+
+var methods = map[string]bool{"get": true, "post": true, "put": true, "patch": true, "delete": true}
+
+type generator struct {
+	spec *spec
+	buf  bytes.Buffer
+	// Structs for inline object schemas, emitted after the named ones.
+	pending []namedSchema
+	// Go names of the schemas under components/schemas.
+	schemaNames map[string]string
+	// Every top-level identifier declared so far. Schemas, inline structs and operations share one namespace.
+	declared map[string]bool
+	// Go types of properties by their JSON name, set with -type.
+	types map[string]string
+	// Hand-written types schemas are aliases of, by schema name, set with -schema.
+	aliases map[string]string
+	// Import paths of the packages those types are declared in, by package name, set with -import.
+	imports map[string]string
+}
+
+// A flag holding name=value pairs, which may be repeated.
+type pairs map[string]string
+
+func (p pairs) String() string {
+	return fmt.Sprint(map[string]string(p))
+}
+
+func (p pairs) Set(value string) error {
+	name, v, ok := strings.Cut(value, "=")
+	if !ok || name == "" || v == "" {
+		return fmt.Errorf("%q is not in the form name=value", value)
+	}
+	p[name] = v
+	return nil
+}
+
+type namedSchema struct {
+	name   string
+	schema *schema
+}
+
+func main() {
+	specPath := flag.String("spec", "", "path to the OpenAPI definition in JSON")
+	out := flag.String("out", "zz_generated.go", "file to write")
+	pkg := flag.String("package", "openapi", "package name of the generated file")
+	types := pairs{}
+	flag.Var(types, "type", "property=pkg.Type giving every property with that JSON name a hand-written type (repeatable)")
+	aliases := pairs{}
+	flag.Var(aliases, "schema", "Schema=pkg.Type declaring a schema as an alias of a hand-written type (repeatable)")
+	imports := pairs{}
+	flag.Var(imports, "import", "name=path importing the package of a -type or -schema (repeatable)")
+	flag.Parse()
+	if *specPath == "" {
+		log.Fatal("-spec is required")
+	}
+	raw, err := os.ReadFile(*specPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s, err := parseSpec(raw)
+	if err != nil {
+		log.Fatalf("parsing %s: %v", *specPath, err)
+	}
+	g := &generator{spec: s, schemaNames: map[string]string{}, declared: map[string]bool{"Doer": true, "Response": true}, types: types, aliases: aliases, imports: imports}
+	src, err := g.generate(*pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Parses a definition in JSON or YAML. YAML is converted to JSON first, so both decode through the same struct tags.
+func parseSpec(raw []byte) (*spec, error) {
+	if !json.Valid(raw) {
+		var doc any
+		if err := yaml.Unmarshal(raw, &doc); err != nil {
+			return nil, err
+		}
+		converted, err := json.Marshal(jsonCompatible(doc))
+		if err != nil {
+			return nil, err
+		}
+		raw = converted
+	}
+	s := &spec{}
+	if err := json.Unmarshal(raw, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Converts maps decoded from YAML to map[string]any, as JSON cannot encode non-string keys such as the response code 200.
+func jsonCompatible(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			v[key] = jsonCompatible(value)
+		}
+		return v
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = jsonCompatible(value)
+		}
+		return m
+	case []any:
+		for i, value := range v {
+			v[i] = jsonCompatible(value)
+		}
+		return v
+	}
+	return v
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) generate(pkg string) ([]byte, error) {
+	g.printf("// Code generated by openapigen from Paystack's OpenAPI definition. DO NOT EDIT.\n\n")
+	g.printf("package %s\n\n", pkg)
+	g.printf("import (\n\t\"context\"\n\t\"encoding/json\"\n\t\"net/url\"\n\t\"strings\"\n\t\"time\"\n")
+	for _, name := range sortedKeys(g.imports) {
+		g.printf("\n\t%s %q\n", name, g.imports[name])
+	}
+	g.printf(")\n\n")
+	g.printf("var (\n\t_ = json.RawMessage{}\n\t_ = time.Time{}\n\t_ = url.PathEscape\n\t_ = strings.NewReplacer\n)\n\n")
+
+	names := sortedKeys(g.spec.Components.Schemas)
+	for _, name := range names {
+		g.schemaNames[name] = g.declare(goName(name))
+	}
+	for _, name := range names {
+		if alias, ok := g.aliases[name]; ok {
+			g.printf("type %s = %s\n\n", g.schemaNames[name], alias)
+			continue
+		}
+		g.typeDecl(g.schemaNames[name], g.spec.Components.Schemas[name])
+	}
+	g.operations()
+	// Inline objects found in schemas and request bodies.
+	for len(g.pending) > 0 {
+		next := g.pending[0]
+		g.pending = g.pending[1:]
+		g.typeDecl(next.name, next.schema)
+	}
+
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return g.buf.Bytes(), fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// Reserves name as a top-level identifier, adding a number if it is already taken, e.g. when operationId "customer" meets schema "Customer".
+func (g *generator) declare(name string) string {
+	unique := name
+	for i := 2; g.declared[unique]; i++ {
+		unique = fmt.Sprintf("%s%d", name, i)
+	}
+	g.declared[unique] = true
+	return unique
+}
+
+// Returns the Go name of the schema ref points to.
+func (g *generator) refType(ref string) string {
+	if name, ok := g.schemaNames[refName(ref)]; ok {
+		return name
+	}
+	return goName(refName(ref))
+}
+
+func (g *generator) typeDecl(name string, s *schema) {
+	if s.Description != "" {
+		g.printf("%s\n", comment(s.Description))
+	}
+	props, required := g.properties(s)
+	if len(props) == 0 {
+		g.printf("type %s = %s\n\n", name, g.goType(name, s))
+		return
+	}
+	g.printf("type %s struct {\n", name)
+	for _, prop := range sortedKeys(props) {
+		field := goName(prop)
+		typ := g.goType(name+field, props[prop])
+		if mapped, ok := g.types[prop]; ok {
+			typ = mapped
+		}
+		// Required fields are always sent, so a false bool or zero amount is not dropped.
+		if required[prop] {
+			g.printf("\t%s %s `json:\"%s\"`\n", field, typ, prop)
+			continue
+		}
+		// Optional scalars are pointers, so an explicit false or zero can still be sent.
+		switch typ {
+		case "bool", "int64", "float64":
+			typ = "*" + typ
+		}
+		g.printf("\t%s %s `json:\"%s,omitempty\"`\n", field, typ, prop)
+	}
+	g.printf("}\n\n")
+}
+
+// Returns the properties of s and the names of the required ones, merging those of allOf members.
+func (g *generator) properties(s *schema) (map[string]*schema, map[string]bool) {
+	props := map[string]*schema{}
+	required := map[string]bool{}
+	if s.Ref != "" {
+		if target, ok := g.spec.Components.Schemas[refName(s.Ref)]; ok {
+			return g.properties(target)
+		}
+	}
+	for name, prop := range s.Properties {
+		props[name] = prop
+	}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	for _, member := range s.AllOf {
+		memberProps, memberRequired := g.properties(member)
+		for name, prop := range memberProps {
+			props[name] = prop
+		}
+		for name := range memberRequired {
+			required[name] = true
+		}
+	}
+	return props, required
+}
+
+// Returns the Go type for s, queueing structs for inline objects under context.
+func (g *generator) goType(context string, s *schema) string {
+	if s == nil {
+		return "json.RawMessage"
+	}
+	if s.Ref != "" {
+		return "*" + g.refType(s.Ref)
+	}
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
+		return "json.RawMessage"
+	}
+	switch typeOf(s) {
+	case "string":
+		if s.Format == "date-time" {
+			return "*time.Time"
+		}
+		return "string"
+	case "integer":
+		return "int64"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		return "[]" + g.goType(context+"Item", s.Items)
+	}
+	if len(s.Properties) > 0 || len(s.AllOf) > 0 {
+		name := g.declare(context)
+		g.pending = append(g.pending, namedSchema{name, s})
+		return "*" + name
+	}
+	return "json.RawMessage"
+}
+
+func (g *generator) operations() {
+	g.printf("// Sends a request to the Paystack API. Implemented by *paystack.Client.\n")
+	g.printf("type Doer interface {\n\tDo(ctx context.Context, method string, path string, reqBody any, respBody any) error\n}\n\n")
+	g.printf("// The envelope every Paystack API response is wrapped in.\n")
+	g.printf("type Response struct {\n\tStatus bool `json:\"status\"`\n\tMessage string `json:\"message\"`\n\tData json.RawMessage `json:\"data\"`\n\tMeta json.RawMessage `json:\"meta\"`\n}\n\n")
+
+	type op struct {
+		path, method string
+		raw          json.RawMessage
+	}
+	ops := []op{}
+	for _, path := range sortedKeys(g.spec.Paths) {
+		for _, method := range sortedKeys(g.spec.Paths[path]) {
+			if methods[method] {
+				ops = append(ops, op{path, method, g.spec.Paths[path][method]})
+			}
+		}
+	}
+	for _, o := range ops {
+		operation := &operation{}
+		if err := json.Unmarshal(o.raw, operation); err != nil {
+			log.Fatalf("parsing %s %s: %v", o.method, o.path, err)
+		}
+		name := goName(operation.OperationId)
+		if name == "" {
+			name = goName(o.method + " " + o.path)
+		}
+		name = g.declare(name)
+		g.operation(name, strings.ToUpper(o.method), o.path, operation)
+	}
+}
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Identifiers used inside generated functions, which parameters must not shadow.
+var reservedArgs = map[string]bool{
+	"ctx": true, "d": true, "query": true, "body": true, "path": true, "resp": true,
+	"context": true, "json": true, "url": true, "strings": true, "time": true,
+}
+
+// Returns the Go parameter name for the path parameter name, renaming keywords such as "type" and names used inside generated functions.
+func argName(name string) string {
+	arg := lowerFirst(goName(name))
+	if arg == "" || token.IsKeyword(arg) || reservedArgs[arg] {
+		arg += "Param"
+	}
+	return arg
+}
+
+// Returns the schema of the operation's first successful JSON response, or nil if it has none.
+func (g *generator) responseSchema(op *operation) *schema {
+	for _, code := range sortedKeys(op.Responses) {
+		if !strings.HasPrefix(code, "2") {
+			continue
+		}
+		resp := op.Responses[code]
+		if resp.Ref != "" {
+			if resolved, ok := g.spec.Components.Responses[refName(resp.Ref)]; ok {
+				resp = resolved
+			}
+		}
+		if media, ok := resp.Content["application/json"]; ok && media.Schema != nil {
+			return media.Schema
+		}
+	}
+	return nil
+}
+
+func (g *generator) operation(name string, method string, path string, op *operation) {
+	params := []string{"ctx context.Context", "d Doer"}
+	pathArgs := []string{}
+	for _, match := range pathParam.FindAllStringSubmatch(path, -1) {
+		arg := argName(match[1])
+		params = append(params, arg+" string")
+		pathArgs = append(pathArgs, fmt.Sprintf("%q, url.PathEscape(%s)", match[0], arg))
+	}
+	hasQuery := false
+	for _, p := range op.Parameters {
+		if p.Ref != "" {
+			if resolved, ok := g.spec.Components.Parameters[refName(p.Ref)]; ok {
+				p = resolved
+			}
+		}
+		if p.In == "query" {
+			hasQuery = true
+		}
+	}
+	if hasQuery {
+		params = append(params, "query url.Values")
+	}
+	bodyType := ""
+	if op.RequestBody != nil {
+		body := op.RequestBody
+		if body.Ref != "" {
+			if resolved, ok := g.spec.Components.RequestBodies[refName(body.Ref)]; ok {
+				body = resolved
+			}
+		}
+		if media, ok := body.Content["application/json"]; ok {
+			bodyType = g.goType(name+"Request", media.Schema)
+			params = append(params, "body "+bodyType)
+		}
+	}
+
+	// Responses are decoded into their schema's struct, or into the generic envelope if the operation has none.
+	respType := "*Response"
+	if schema := g.responseSchema(op); schema != nil {
+		if t := g.goType(name+"Response", schema); strings.HasPrefix(t, "*") {
+			respType = t
+		}
+	}
+
+	if op.Summary != "" {
+		g.printf("%s\n//\n", comment(op.Summary))
+	}
+	g.printf("// %s %s\n", method, path)
+	g.printf("func %s(%s) (%s, error) {\n", name, strings.Join(params, ", "), respType)
+	if len(pathArgs) > 0 {
+		g.printf("\tpath := strings.NewReplacer(%s).Replace(%q)\n", strings.Join(pathArgs, ", "), path)
+	} else {
+		g.printf("\tpath := %q\n", path)
+	}
+	if hasQuery {
+		g.printf("\tif len(query) > 0 {\n\t\tpath += \"?\" + query.Encode()\n\t}\n")
+	}
+	reqBody := "nil"
+	if bodyType != "" {
+		reqBody = "body"
+	}
+	g.printf("\tresp := &%s{}\n", strings.TrimPrefix(respType, "*"))
+	g.printf("\tif err := d.Do(ctx, %q, path, %s, resp); err != nil {\n\t\treturn nil, err\n\t}\n", method, reqBody)
+	g.printf("\treturn resp, nil\n}\n\n")
+}
+
+func typeOf(s *schema) string {
+	switch t := s.Type.(type) {
+	case string:
+		return t
+	case []any:
+		// OpenAPI 3.1 allows ["string", "null"].
+		for _, v := range t {
+			if name, ok := v.(string); ok && name != "null" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// Abbreviations are written the way this package already spells them, e.g. Id and Url.
+func goName(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			b.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	name := b.String()
+	if name != "" && unicode.IsDigit(rune(name[0])) {
+		name = "N" + name
+	}
+	return name
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+func comment(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("// "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
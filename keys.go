@@ -0,0 +1,40 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+)
+
+type keyNameKey struct{}
+
+// Registers an additional secret key under name, e.g. a country code or merchant id, for multi-tenant platforms using several Paystack integrations.
+// Select it for a call with ContextWithKey. Calls without a selected key use the secret passed to NewClient.
+func WithKey(name string, secret string) Option {
+	return func(c *Client) {
+		if c.keys == nil {
+			c.keys = map[string]string{}
+		}
+		c.keys[name] = secret
+	}
+}
+
+// Returns a copy of ctx that makes calls use the secret key registered under name with WithKey.
+func ContextWithKey(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, keyNameKey{}, name)
+}
+
+// Returns the secret key to authenticate a call made with ctx.
+func (c *Client) secretFor(ctx context.Context) (string, error) {
+	name, ok := ctx.Value(keyNameKey{}).(string)
+	if !ok {
+		if c.secret == "" {
+			return "", fmt.Errorf("paystack: no default secret key and no key selected with ContextWithKey")
+		}
+		return c.secret, nil
+	}
+	secret, ok := c.keys[name]
+	if !ok {
+		return "", fmt.Errorf("paystack: no secret key registered under %q", name)
+	}
+	return secret, nil
+}
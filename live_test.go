@@ -0,0 +1,114 @@
+package paystack_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/acudac-com/paystack-go"
+)
+
+// Exercises the package against Paystack's test mode API, to catch regressions against real Paystack behaviour before a release.
+// It is skipped unless PAYSTACK_TEST_SECRET holds a test secret key:
+//
+//	PAYSTACK_TEST_SECRET=sk_test_xxx go test -run TestLive
+//
+// Refunds are made against the most recent successful test transaction, or the one whose reference is in PAYSTACK_TEST_REFUND_TRANSACTION.
+func TestLive(t *testing.T) {
+	secret := os.Getenv("PAYSTACK_TEST_SECRET")
+	if secret == "" {
+		t.Skip("PAYSTACK_TEST_SECRET not set")
+	}
+	if !strings.HasPrefix(secret, "sk_test_") {
+		t.Fatal("PAYSTACK_TEST_SECRET must be a test secret key")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+	c := paystack.NewClient(secret)
+	suffix := fmt.Sprintf("%d", time.Now().UnixNano())
+	email := "livetest+" + suffix + "@example.com"
+
+	t.Run("ValidateCredentials", func(t *testing.T) {
+		integration, err := c.ValidateCredentials(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if integration.Mode != paystack.KeyModeTest {
+			t.Errorf("key mode %q, want %q", integration.Mode, paystack.KeyModeTest)
+		}
+	})
+
+	t.Run("CreateCustomer", func(t *testing.T) {
+		customer, err := c.CreateCustomer(ctx, email)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if customer.CustomerCode == "" {
+			t.Error("customer has no code")
+		}
+	})
+
+	t.Run("InitializeAndVerifyTransaction", func(t *testing.T) {
+		initialized, err := c.InitializeTransaction(ctx, email, 10000, "https://example.com/callback")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if initialized.Reference == "" || initialized.AuthorizationUrl == "" {
+			t.Fatal("initialized transaction is missing its reference or authorization url")
+		}
+		verified, err := c.VerifyTransaction(ctx, initialized.Reference)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if verified.Reference != initialized.Reference {
+			t.Errorf("verified reference %q, want %q", verified.Reference, initialized.Reference)
+		}
+	})
+
+	t.Run("CreateAndFetchRecipient", func(t *testing.T) {
+		recipient, err := c.CreateRecipient(ctx, &paystack.NewRecipient{
+			Type:          paystack.RecipientNuban,
+			Name:          "Livetest " + suffix,
+			AccountNumber: "0001234567",
+			BankCode:      "058",
+			Currency:      "NGN",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			if err := c.DeleteRecipient(context.Background(), recipient.RecipientCode); err != nil {
+				t.Errorf("deleting recipient: %v", err)
+			}
+		})
+		fetched, err := c.FetchRecipient(ctx, recipient.RecipientCode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fetched.RecipientCode != recipient.RecipientCode {
+			t.Errorf("fetched recipient %q, want %q", fetched.RecipientCode, recipient.RecipientCode)
+		}
+	})
+
+	t.Run("CreateAndFetchRefund", func(t *testing.T) {
+		// Refunds take money from a transaction the suite did not create, so they only run against one set aside for it.
+		ref := os.Getenv("PAYSTACK_TEST_REFUND_TRANSACTION")
+		if ref == "" {
+			t.Skip("PAYSTACK_TEST_REFUND_TRANSACTION not set")
+		}
+		refund, err := c.CreateRefund(ctx, &paystack.NewRefund{Transaction: ref, Amount: 100, MerchantNote: "livetest " + suffix})
+		if err != nil {
+			t.Fatal(err)
+		}
+		fetched, err := c.FetchRefund(ctx, refund.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fetched.Id != refund.Id {
+			t.Errorf("fetched refund %d, want %d", fetched.Id, refund.Id)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package paystack
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type Bank struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Slug        string    `json:"slug"`
+	Code        string    `json:"code"`
+	Longcode    string    `json:"longcode"`
+	Gateway     string    `json:"gateway"`
+	Country     string    `json:"country"`
+	Currency    string    `json:"currency"`
+	Type        string    `json:"type"`
+	PayWithBank bool      `json:"pay_with_bank"`
+	Active      bool      `json:"active"`
+	IsDeleted   bool      `json:"is_deleted"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type ListBanksOptions struct {
+	// Only include banks in this country, e.g. "nigeria" or "south africa".
+	Country  string
+	Currency string
+	// Only include banks of this type, e.g. "nuban", "mobile_money" or "basa".
+	Type                   string
+	PayWithBank            bool
+	PayWithBankTransfer    bool
+	EnabledForVerification bool
+	PerPage                int
+	// Cursors returned in the meta of a previous page.
+	Next     string
+	Previous string
+}
+
+// Cursors returned alongside cursor paginated list responses.
+type CursorMeta struct {
+	Next     string `json:"next"`
+	Previous string `json:"previous"`
+	PerPage  int    `json:"perPage"`
+}
+
+// Lists the banks supported by Paystack, optionally filtered by country, currency, type and features.
+func (c *Client) ListBanks(ctx context.Context, opts *ListBanksOptions) ([]*Bank, *CursorMeta, error) {
+	type ListBanksResp struct {
+		Data []*Bank     `json:"data"`
+		Meta *CursorMeta `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListBanksOptions{}
+	}
+	q := url.Values{}
+	q.Set("use_cursor", "true")
+	if opts.Country != "" {
+		q.Set("country", opts.Country)
+	}
+	if opts.Currency != "" {
+		q.Set("currency", opts.Currency)
+	}
+	if opts.Type != "" {
+		q.Set("type", opts.Type)
+	}
+	if opts.PayWithBank {
+		q.Set("pay_with_bank", "true")
+	}
+	if opts.PayWithBankTransfer {
+		q.Set("pay_with_bank_transfer", "true")
+	}
+	if opts.EnabledForVerification {
+		q.Set("enabled_for_verification", "true")
+	}
+	if opts.PerPage > 0 {
+		q.Set("perPage", strconv.Itoa(opts.PerPage))
+	}
+	if opts.Next != "" {
+		q.Set("next", opts.Next)
+	}
+	if opts.Previous != "" {
+		q.Set("previous", opts.Previous)
+	}
+	u := withQuery("https://api.paystack.co/bank", q)
+	respBody := &ListBanksResp{}
+	if err := c.cachedRequest(ctx, u, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+type CountryRelationship struct {
+	Type string   `json:"type"`
+	Data []string `json:"data"`
+}
+
+type CountryRelationships struct {
+	Currency           *CountryRelationship `json:"currency"`
+	IntegrationFeature *CountryRelationship `json:"integration_feature"`
+	IntegrationType    *CountryRelationship `json:"integration_type"`
+	PaymentMethod      *CountryRelationship `json:"payment_method"`
+}
+
+type Country struct {
+	Id                  int                   `json:"id"`
+	Name                string                `json:"name"`
+	IsoCode             string                `json:"iso_code"`
+	DefaultCurrencyCode string                `json:"default_currency_code"`
+	Relationships       *CountryRelationships `json:"relationships"`
+}
+
+// Lists the countries Paystack supports, with their currencies and the features available to integrations there.
+func (c *Client) ListCountries(ctx context.Context) ([]*Country, error) {
+	type ListCountriesResp struct {
+		Data []*Country `json:"data"`
+	}
+	u := "https://api.paystack.co/country"
+	respBody := &ListCountriesResp{}
+	if err := c.cachedRequest(ctx, u, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type State struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	Abbreviation string `json:"abbreviation"`
+}
+
+// Lists the states accepted in address verification for the country with the given ISO code, e.g. "US" or "CA".
+func (c *Client) ListStates(ctx context.Context, country string) ([]*State, error) {
+	type ListStatesResp struct {
+		Data []*State `json:"data"`
+	}
+	q := url.Values{}
+	q.Set("country", country)
+	u := withQuery("https://api.paystack.co/address_verification/states", q)
+	respBody := &ListStatesResp{}
+	if err := c.cachedRequest(ctx, u, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
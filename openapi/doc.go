@@ -0,0 +1,9 @@
+// Package openapi holds types and endpoint stubs generated from the OpenAPI definition in paystack.json.
+//
+// The checked-in definition is a curated subset of Paystack's API covering endpoints without a hand-written wrapper, such as plans, subaccounts, splits and products.
+// To cover more, replace it with the full definition published at https://github.com/PaystackOSS/openapi, which the generator reads as YAML, and point -spec at it before running go generate.
+// Bearer and settlement schedule properties use the enums of package paystack, amounts and prices decode into paystack.Amount, and Plan is paystack.Plan.
+// Functions take a Doer, which *paystack.Client implements, and return the decoded response envelope.
+package openapi
+
+//go:generate go run ../internal/openapigen -spec paystack.json -out zz_generated.go -package openapi -import paystack=github.com/acudac-com/paystack-go -type bearer_type=paystack.Bearer -type settlement_schedule=paystack.SettlementSchedule -type amount=paystack.Amount -type price=paystack.Amount -schema Plan=paystack.Plan
@@ -0,0 +1,51 @@
+package paystack
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Pagination and date filters accepted by the list endpoints. Zero values are omitted.
+type ListOptions struct {
+	PerPage int
+	Page    int
+	From    time.Time
+	To      time.Time
+}
+
+func (o *ListOptions) values() url.Values {
+	q := url.Values{}
+	if o == nil {
+		return q
+	}
+	if o.PerPage > 0 {
+		q.Set("perPage", strconv.Itoa(o.PerPage))
+	}
+	if o.Page > 0 {
+		q.Set("page", strconv.Itoa(o.Page))
+	}
+	if !o.From.IsZero() {
+		q.Set("from", o.From.UTC().Format(time.RFC3339))
+	}
+	if !o.To.IsZero() {
+		q.Set("to", o.To.UTC().Format(time.RFC3339))
+	}
+	return q
+}
+
+// Pagination details returned alongside list responses.
+type Meta struct {
+	Total     int `json:"total"`
+	Skipped   int `json:"skipped"`
+	PerPage   int `json:"perPage"`
+	Page      int `json:"page"`
+	PageCount int `json:"pageCount"`
+}
+
+func withQuery(u string, q url.Values) string {
+	if len(q) == 0 {
+		return u
+	}
+	return u + "?" + q.Encode()
+}
@@ -0,0 +1,84 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// The state of a payout when Payout returns.
+type PayoutStatus string
+
+const (
+	PayoutSuccess   PayoutStatus = "success"
+	PayoutFailed    PayoutStatus = "failed"
+	PayoutReversed  PayoutStatus = "reversed"
+	PayoutAbandoned PayoutStatus = "abandoned"
+	PayoutBlocked   PayoutStatus = "blocked"
+	PayoutRejected  PayoutStatus = "rejected"
+	// The transfer was initiated but must be finalized with an OTP before it is processed.
+	PayoutOtpRequired PayoutStatus = "otp"
+	// The transfer may or may not have been initiated and had not settled when ctx was done. Call Payout again with the same reference to resume.
+	PayoutPending PayoutStatus = "pending"
+)
+
+type PayoutOutcome struct {
+	Status PayoutStatus
+	// The reference the transfer was initiated with.
+	Reference string
+	// The transfer's latest state. Nil if it could not be initiated or verified yet.
+	Transfer *Transfer
+}
+
+// Initiates the transfer and polls its status every pollInterval until it reaches a final status or ctx is done, in which case the outcome is pending.
+// The reference is derived from keys the same way InitiateTransferOnce derives it if the transfer has none,
+// so calling Payout or InitiateTransferOnce again with the same keys or reference resumes the existing transfer instead of paying out twice.
+// Returns no outcome with a *ValidationError, a *DryRunError or an error wrapping ErrTransferMismatch, as Paystack did not accept the transfer.
+// Other errors still come with an outcome holding the reference, since Paystack may have accepted the transfer before the error.
+// PollInterval defaults to 5 seconds.
+func (c *Client) Payout(ctx context.Context, transfer *NewTransfer, pollInterval time.Duration, keys ...string) (*PayoutOutcome, error) {
+	if pollInterval <= 0 {
+		pollInterval = 5 * time.Second
+	}
+	reqBody, err := withTransferReference(transfer, keys)
+	if err != nil {
+		return nil, err
+	}
+	pending := &PayoutOutcome{Status: PayoutPending, Reference: reqBody.Reference}
+	initiated, err := c.InitiateTransferOnce(ctx, reqBody)
+	if err != nil {
+		var validationErr *ValidationError
+		var dryRunErr *DryRunError
+		if errors.As(err, &validationErr) || errors.As(err, &dryRunErr) || errors.Is(err, ErrTransferMismatch) {
+			return nil, err
+		}
+		if ctx.Err() != nil {
+			return pending, nil
+		}
+		return pending, err
+	}
+	if initiated.Status == string(PayoutOtpRequired) {
+		return &PayoutOutcome{Status: PayoutOtpRequired, Reference: reqBody.Reference, Transfer: initiated}, nil
+	}
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		verified, err := c.VerifyTransfer(ctx, reqBody.Reference)
+		if err != nil {
+			if ctx.Err() != nil {
+				return pending, nil
+			}
+			return pending, err
+		}
+		pending.Transfer = verified
+		switch status := PayoutStatus(verified.Status); status {
+		case PayoutSuccess, PayoutFailed, PayoutReversed, PayoutAbandoned, PayoutBlocked, PayoutRejected:
+			return &PayoutOutcome{Status: status, Reference: reqBody.Reference, Transfer: verified}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return pending, nil
+		case <-ticker.C:
+		}
+	}
+}
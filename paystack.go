@@ -4,66 +4,214 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 )
 
 type Client struct {
-	secret string
+	secret            string
+	cache             Cache
+	cacheTtl          time.Duration
+	correlationHeader string
+	correlationId     func(ctx context.Context) string
+	logger            *slog.Logger
+	apiVersion        string
+	retryPolicy       RetryPolicy
+	keys              map[string]string
+	transport         *http.Transport
+	httpClient        *http.Client
+	dryRun            bool
 }
 
+// Configures optional behaviour of a Client.
+type Option func(*Client)
+
 // Create a new paystack client. Panics if PAYSTACK_SECRET env not set.
-func NewClient(secret string) *Client {
-	return &Client{
-		secret: secret,
+func NewClient(secret string, opts ...Option) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	c := &Client{
+		secret:     secret,
+		transport:  transport,
+		httpClient: &http.Client{Transport: transport},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Pins the API version sent in the Paystack-Version header of every request, so response changes only apply once the version is bumped.
+func WithAPIVersion(version string) Option {
+	return func(c *Client) {
+		c.apiVersion = version
 	}
 }
 
+// Sends a request to an endpoint of the Paystack API that has no dedicated method, e.g. path "/plan".
+// ReqBody is sent as JSON and the full JSON response, including its status and message, is decoded into respBody.
+func (c *Client) Do(ctx context.Context, method string, path string, reqBody any, respBody any) error {
+	return c.request(ctx, "https://api.paystack.co"+path, method, reqBody, respBody)
+}
+
 func (c *Client) request(ctx context.Context, url string, method string, req_body any, resp_body any) error {
+	resBody, err := c.do(ctx, url, method, req_body)
+	if err != nil {
+		return err
+	}
+	if resp_body != nil {
+		if err := json.Unmarshal(resBody, resp_body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, url string, method string, req_body any) ([]byte, error) {
+	if v, ok := req_body.(validator); ok {
+		if err := v.validate(); err != nil {
+			return nil, err
+		}
+	}
+	dryRun := c.isDryRun(ctx)
 	body := []byte{}
 	var err error
 	if req_body != nil {
 		body, err = json.Marshal(req_body)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
+	secret, err := c.secretFor(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	req.Header.Set("Authorization", "Bearer "+c.secret)
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return err
+	correlationId := ""
+	if c.correlationId != nil {
+		correlationId = c.correlationId(ctx)
 	}
-	defer resp.Body.Close()
-	resBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return err
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewBuffer(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+secret)
+		if c.apiVersion != "" {
+			req.Header.Set("Paystack-Version", c.apiVersion)
+		}
+		if correlationId != "" {
+			req.Header.Set(c.correlationHeader, correlationId)
+		}
+		if dryRun {
+			return nil, &DryRunError{Request: req, Body: body}
+		}
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		redactQuery(err)
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		c.logRequest(ctx, method, url, correlationId, attempt, status, start, err)
+		if c.retryPolicy != nil && ctx.Err() == nil && c.retryPolicy.ShouldRetry(req, resp, err, attempt) {
+			if resp != nil {
+				io.Copy(io.Discard, resp.Body)
+				resp.Body.Close()
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.retryPolicy.NextDelay(attempt)):
+			}
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("paystack: %s %s: %w", method, path(url), err)
+		}
+		defer resp.Body.Close()
+		resBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("paystack: %s %s: reading response: %w", method, path(url), err)
+		}
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return nil, newAPIError(resp.StatusCode, resBody)
+		}
+		return resBody, nil
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("%s", string(resBody))
+}
+
+func (c *Client) logRequest(ctx context.Context, method string, url string, correlationId string, attempt int, status int, start time.Time, err error) {
+	if c.logger == nil {
+		return
 	}
-	if resp_body != nil {
-		if err := json.Unmarshal(resBody, resp_body); err != nil {
-			return err
-		}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.String("url", path(url)),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if correlationId != "" {
+		attrs = append(attrs, slog.String("correlation_id", correlationId))
+	}
+	if attempt > 1 {
+		attrs = append(attrs, slog.Int("attempt", attempt))
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	} else {
+		attrs = append(attrs, slog.Int("status", status))
+	}
+	c.logger.LogAttrs(ctx, slog.LevelDebug, "paystack request", attrs...)
+}
+
+// Returns url without its query string, which can hold account numbers and emails that should not end up in logs or errors.
+func path(url string) string {
+	path, _, _ := strings.Cut(url, "?")
+	return path
+}
+
+// Strips the query string from the URL of a *url.Error, which the HTTP client sets to the full request URL.
+func redactQuery(err error) {
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		urlErr.URL = path(urlErr.URL)
 	}
-	return nil
 }
 
 type Customer struct {
-	Id           int    `json:"id"`
-	Email        string `json:"email"`
-	CustomerCode string `json:"customer_code"`
+	Id           int        `json:"id"`
+	Email        string     `json:"email"`
+	CustomerCode string     `json:"customer_code"`
+	FirstName    string     `json:"first_name,omitempty"`
+	LastName     string     `json:"last_name,omitempty"`
+	Phone        string     `json:"phone,omitempty"`
+	RiskAction   RiskAction `json:"risk_action,omitempty"`
 }
 
-// Test if the provided credentials are valid by making a GET request to /customers.
-func (c *Client) ValidateCredentials(ctx context.Context) error {
-	url := "https://api.paystack.co/customer"
-	return c.request(ctx, url, "GET", nil, nil)
+// Test if the provided credentials are valid by making a GET request to /integration, returning which integration they belong to and in which mode.
+func (c *Client) ValidateCredentials(ctx context.Context) (*Integration, error) {
+	type ValidateCredentialsResp struct {
+		Data *Integration `json:"data"`
+	}
+	secret, err := c.secretFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	url := "https://api.paystack.co/integration"
+	respBody := &ValidateCredentialsResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	integration := respBody.Data
+	if integration == nil {
+		integration = &Integration{}
+	}
+	integration.Mode = keyMode(secret)
+	return integration, nil
 }
 
 // Creates a new customer with the specified email and returns the new customer's id and code.
@@ -81,25 +229,61 @@ func (c *Client) CreateCustomer(ctx context.Context, email string) (*Customer, e
 	return respBody.Data, nil
 }
 
+// Whitelists or blacklists the customer with the given code or email, or resets them to the default fraud checks.
+func (c *Client) SetCustomerRiskAction(ctx context.Context, customer string, action RiskAction) (*Customer, error) {
+	type SetRiskActionReq struct {
+		Customer   string     `json:"customer"`
+		RiskAction RiskAction `json:"risk_action"`
+	}
+	type SetRiskActionResp struct {
+		Data *Customer `json:"data"`
+	}
+	if !action.Valid() {
+		return nil, &ValidationError{Errors: []FieldError{{Field: "risk_action", Message: fmt.Sprintf("%q is not a supported risk action", action)}}}
+	}
+	url := "https://api.paystack.co/customer/set_risk_action"
+	reqBody := &SetRiskActionReq{Customer: customer, RiskAction: action}
+	respBody := &SetRiskActionResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
 type InitializedTransaction struct {
 	Reference        string `json:"reference"`
 	AuthorizationUrl string `json:"authorization_url"`
 	AccessCode       string `json:"access_code"`
 }
 
+// How the proceeds of a transaction are split with subaccounts.
+type TransactionSplit struct {
+	// The code of the subaccount that receives a share of the payment.
+	Subaccount string `json:"subaccount,omitempty"`
+	// The code of a multi-split to apply instead of a single subaccount.
+	SplitCode string `json:"split_code,omitempty"`
+	// Who pays the Paystack fees. Defaults to the integration's account.
+	Bearer Bearer `json:"bearer,omitempty"`
+	// A flat amount in the smallest unit the integration keeps, overriding the subaccount's percentage share.
+	TransactionCharge int64 `json:"transaction_charge,omitempty"`
+}
+
+type initTransactionReq struct {
+	Email       string `json:"email"`
+	Amount      string `json:"amount"`
+	CallbackUrl string `json:"callback_url"`
+	*TransactionSplit
+	amount int64
+}
+
 // Initializes a new transaction for the customer with the given email.
 // Amount is in the smallest unit, e.g. cents instead of ZAR.
 func (c *Client) InitializeTransaction(ctx context.Context, email string, amount int32, callbackUrl string) (*InitializedTransaction, error) {
-	type InitTransactionReq struct {
-		Email       string `json:"email"`
-		Amount      string `json:"amount"`
-		CallbackUrl string `json:"callback_url"`
-	}
 	type InitTransactionResp struct {
 		Data *InitializedTransaction
 	}
 	url := "https://api.paystack.co/transaction/initialize"
-	reqBody := &InitTransactionReq{email, fmt.Sprintf("%d", amount), callbackUrl}
+	reqBody := &initTransactionReq{Email: email, Amount: fmt.Sprintf("%d", amount), CallbackUrl: callbackUrl, amount: int64(amount)}
 	respBody := &InitTransactionResp{}
 	err := c.request(ctx, url, "POST", reqBody, respBody)
 	if err != nil {
@@ -108,18 +292,36 @@ func (c *Client) InitializeTransaction(ctx context.Context, email string, amount
 	return respBody.Data, nil
 }
 
+// Initializes a new transaction like InitializeTransaction whose proceeds are split with a subaccount or multi-split.
+// Returns a *ValidationError without calling Paystack if split names neither or both, or has an unsupported bearer.
+func (c *Client) InitializeSplitTransaction(ctx context.Context, email string, amount int32, callbackUrl string, split *TransactionSplit) (*InitializedTransaction, error) {
+	type InitTransactionResp struct {
+		Data *InitializedTransaction
+	}
+	url := "https://api.paystack.co/transaction/initialize"
+	reqBody := &initTransactionReq{Email: email, Amount: fmt.Sprintf("%d", amount), CallbackUrl: callbackUrl, TransactionSplit: split, amount: int64(amount)}
+	respBody := &InitTransactionResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type chargeAuthorizationReq struct {
+	Email             string `json:"email"`
+	Amount            string `json:"amount"`
+	AuthorizationCode string `json:"authorization_code"`
+	Queue             bool   `json:"queue,omitempty"`
+	amount            int64
+}
+
 // Charges the customer with the given email with one of their existing authorization codes.
 func (c *Client) ChargeAuthorization(ctx context.Context, email string, amount int32, authCode string) (*InitializedTransaction, error) {
-	type ChargeTransactionReq struct {
-		Email             string `json:"email"`
-		Amount            string `json:"amount"`
-		AuthorizationCode string `json:"authorization_code"`
-	}
 	type ChargeTransactionResp struct {
 		Data *InitializedTransaction
 	}
 	url := "https://api.paystack.co/transaction/charge_authorization"
-	reqBody := &ChargeTransactionReq{Email: email, Amount: fmt.Sprintf("%d", amount), AuthorizationCode: authCode}
+	reqBody := &chargeAuthorizationReq{Email: email, Amount: fmt.Sprintf("%d", amount), AuthorizationCode: authCode, amount: int64(amount)}
 	respBody := &ChargeTransactionResp{}
 	err := c.request(ctx, url, "POST", reqBody, respBody)
 	if err != nil {
@@ -145,10 +347,13 @@ type Authorization struct {
 }
 
 type VerifiedTransaction struct {
-	Id            int            `json:"id"`
-	Reference     string         `json:"reference"`
-	Status        string         `json:"status"`
-	Authorization *Authorization `json:"authorization"`
+	Id              int            `json:"id"`
+	Reference       string         `json:"reference"`
+	Status          string         `json:"status"`
+	Amount          Amount         `json:"amount,omitempty"`
+	Currency        string         `json:"currency,omitempty"`
+	GatewayResponse string         `json:"gateway_response,omitempty"`
+	Authorization   *Authorization `json:"authorization"`
 }
 
 // Verifies a transaction with the given reference. The returned status could be "success", "failed", or anything else indicating its pending.
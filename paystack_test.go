@@ -0,0 +1,39 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type failingTransport struct {
+	err error
+}
+
+func (t failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, t.err
+}
+
+func TestRequestErrorsOmitQuery(t *testing.T) {
+	logs := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	boom := errors.New("boom")
+	c := NewClient("sk_test_secret", WithLogger(logger), WithHTTPClient(&http.Client{Transport: failingTransport{boom}}))
+
+	_, err := c.ResolveAccount(context.Background(), "0123456789", "058")
+	if !errors.Is(err, boom) {
+		t.Fatalf("got error %v, want it to wrap %v", err, boom)
+	}
+	for name, text := range map[string]string{"error": err.Error(), "log": logs.String()} {
+		if strings.Contains(text, "0123456789") || strings.Contains(text, "bank_code") {
+			t.Errorf("%s contains the query string: %s", name, text)
+		}
+		if !strings.Contains(text, "/bank/resolve") {
+			t.Errorf("%s does not name the endpoint: %s", name, text)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package paystack
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Queues a charge against one of the customer's existing authorization codes instead of processing it immediately.
+// Paystack recommends queueing large recurring billing runs. Track the returned reference to completion with a QueuedChargeTracker.
+func (c *Client) QueueChargeAuthorization(ctx context.Context, email string, amount int32, authCode string) (*InitializedTransaction, error) {
+	type QueueChargeResp struct {
+		Data *InitializedTransaction
+	}
+	url := "https://api.paystack.co/transaction/charge_authorization"
+	reqBody := &chargeAuthorizationReq{Email: email, Amount: fmt.Sprintf("%d", amount), AuthorizationCode: authCode, Queue: true, amount: int64(amount)}
+	respBody := &QueueChargeResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Reports whether a transaction with the given status will not change anymore.
+func transactionSettled(status string) bool {
+	switch status {
+	case "success", "failed", "abandoned", "reversed":
+		return true
+	}
+	return false
+}
+
+// How long a charge that never settles stays tracked, e.g. after its Wait timed out.
+const queuedChargeTtl = 24 * time.Hour
+
+type queuedCharge struct {
+	done      chan struct{}
+	tx        *VerifiedTransaction
+	trackedAt time.Time
+}
+
+// Tracks queued charges until they settle, using charge.success webhooks when wired up with HandleChargeSuccess and polling VerifyTransaction otherwise.
+// Charges stop being tracked once they settle, or after 24 hours if they never do, when expired ones are swept as the tracker doubles in size.
+// Safe for concurrent use.
+type QueuedChargeTracker struct {
+	client       *Client
+	pollInterval time.Duration
+	mu           sync.Mutex
+	charges      map[string]*queuedCharge
+	// The number of tracked charges at which expired ones are next swept.
+	sweepAt int
+}
+
+// Creates a tracker that polls unsettled charges every pollInterval, defaulting to 1 minute.
+func (c *Client) NewQueuedChargeTracker(pollInterval time.Duration) *QueuedChargeTracker {
+	if pollInterval <= 0 {
+		pollInterval = time.Minute
+	}
+	return &QueuedChargeTracker{client: c, pollInterval: pollInterval, charges: map[string]*queuedCharge{}, sweepAt: minSweepSize}
+}
+
+// Queues a charge with QueueChargeAuthorization and starts tracking it, returning its reference.
+func (t *QueuedChargeTracker) Charge(ctx context.Context, email string, amount int32, authCode string) (string, error) {
+	tx, err := t.client.QueueChargeAuthorization(ctx, email, amount, authCode)
+	if err != nil {
+		return "", err
+	}
+	t.Track(tx.Reference)
+	return tx.Reference, nil
+}
+
+// Starts tracking the queued charge with the given reference.
+func (t *QueuedChargeTracker) Track(ref string) {
+	t.charge(ref)
+}
+
+// Returns the tracked charge with the given reference, tracking it if it is not already, and whether it was already tracked.
+func (t *QueuedChargeTracker) charge(ref string) (*queuedCharge, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	if len(t.charges) >= t.sweepAt {
+		for key, charge := range t.charges {
+			if now.Sub(charge.trackedAt) > queuedChargeTtl {
+				delete(t.charges, key)
+			}
+		}
+		t.sweepAt = max(2*len(t.charges), minSweepSize)
+	}
+	charge, ok := t.charges[ref]
+	if ok && now.Sub(charge.trackedAt) > queuedChargeTtl {
+		ok = false
+	}
+	if !ok {
+		charge = &queuedCharge{done: make(chan struct{}), trackedAt: now}
+		t.charges[ref] = charge
+	}
+	return charge, ok
+}
+
+func (t *QueuedChargeTracker) settle(ref string, tx *VerifiedTransaction) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	charge, ok := t.charges[ref]
+	if !ok {
+		return
+	}
+	delete(t.charges, ref)
+	charge.tx = tx
+	close(charge.done)
+}
+
+// Settles tracked charges from charge.success webhooks. Register it with WebhookHandler.OnChargeSuccess.
+func (t *QueuedChargeTracker) HandleChargeSuccess(ctx context.Context, data *ChargeSuccessData) error {
+	t.settle(data.Reference, &VerifiedTransaction{
+		Id:              data.Id,
+		Reference:       data.Reference,
+		Status:          data.Status,
+		Amount:          data.Amount,
+		Currency:        data.Currency,
+		GatewayResponse: data.GatewayResponse,
+		Authorization:   data.Authorization,
+	})
+	return nil
+}
+
+// Waits until the charge with the given reference settles or ctx is done, and returns its final state.
+// The charge's status is "success", "failed", "abandoned" or "reversed".
+// If the reference is not tracked, e.g. because a webhook already settled it, the charge is verified right away and tracked until it settles.
+func (t *QueuedChargeTracker) Wait(ctx context.Context, ref string) (*VerifiedTransaction, error) {
+	charge, tracked := t.charge(ref)
+	ticker := time.NewTicker(t.pollInterval)
+	defer ticker.Stop()
+	verify := !tracked
+	for {
+		if verify {
+			tx, err := t.client.VerifyTransaction(ctx, ref)
+			if err != nil {
+				return nil, err
+			}
+			if transactionSettled(tx.Status) {
+				t.settle(ref, tx)
+				return tx, nil
+			}
+		}
+		select {
+		case <-charge.done:
+			return charge.tx, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			verify = true
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package paystack
+
+import (
+	"context"
+	"time"
+)
+
+// The kind of account a transfer recipient is paid into.
+type RecipientType string
+
+const (
+	RecipientNuban         RecipientType = "nuban"
+	RecipientMobileMoney   RecipientType = "mobile_money"
+	RecipientBasa          RecipientType = "basa"
+	RecipientAuthorization RecipientType = "authorization"
+	RecipientGhipss        RecipientType = "ghipss"
+)
+
+// Reports whether t is one of the recipient types supported by Paystack.
+func (t RecipientType) Valid() bool {
+	switch t {
+	case RecipientNuban, RecipientMobileMoney, RecipientBasa, RecipientAuthorization, RecipientGhipss:
+		return true
+	}
+	return false
+}
+
+type RecipientDetails struct {
+	AuthorizationCode string `json:"authorization_code"`
+	AccountNumber     string `json:"account_number"`
+	AccountName       string `json:"account_name"`
+	BankCode          string `json:"bank_code"`
+	BankName          string `json:"bank_name"`
+}
+
+type Recipient struct {
+	Id            int               `json:"id"`
+	RecipientCode string            `json:"recipient_code"`
+	Type          RecipientType     `json:"type"`
+	Name          string            `json:"name"`
+	Email         string            `json:"email"`
+	Description   string            `json:"description"`
+	Currency      string            `json:"currency"`
+	Domain        string            `json:"domain"`
+	Active        bool              `json:"active"`
+	IsDeleted     bool              `json:"is_deleted"`
+	Details       *RecipientDetails `json:"details"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
+}
+
+type NewRecipient struct {
+	Type              RecipientType `json:"type"`
+	Name              string        `json:"name"`
+	Email             string        `json:"email,omitempty"`
+	AccountNumber     string        `json:"account_number,omitempty"`
+	BankCode          string        `json:"bank_code,omitempty"`
+	AuthorizationCode string        `json:"authorization_code,omitempty"`
+	Currency          string        `json:"currency,omitempty"`
+	Description       string        `json:"description,omitempty"`
+	Metadata          any           `json:"metadata,omitempty"`
+}
+
+// Creates a new transfer recipient. Returns a *ValidationError without calling Paystack if the recipient type is not supported or its required details are missing.
+func (c *Client) CreateRecipient(ctx context.Context, recipient *NewRecipient) (*Recipient, error) {
+	type CreateRecipientResp struct {
+		Data *Recipient `json:"data"`
+	}
+	url := "https://api.paystack.co/transferrecipient"
+	respBody := &CreateRecipientResp{}
+	if err := c.request(ctx, url, "POST", recipient, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Lists the transfer recipients on the integration, optionally filtered by creation date.
+func (c *Client) ListRecipients(ctx context.Context, opts *ListOptions) ([]*Recipient, *Meta, error) {
+	type ListRecipientsResp struct {
+		Data []*Recipient `json:"data"`
+		Meta *Meta        `json:"meta"`
+	}
+	url := withQuery("https://api.paystack.co/transferrecipient", opts.values())
+	respBody := &ListRecipientsResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the transfer recipient with the given id or recipient code.
+func (c *Client) FetchRecipient(ctx context.Context, idOrCode string) (*Recipient, error) {
+	type FetchRecipientResp struct {
+		Data *Recipient `json:"data"`
+	}
+	url := "https://api.paystack.co/transferrecipient/" + idOrCode
+	respBody := &FetchRecipientResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Updates the name and email of the transfer recipient with the given id or recipient code.
+// An empty email leaves the recipient's email unchanged.
+func (c *Client) UpdateRecipient(ctx context.Context, idOrCode string, name string, email string) error {
+	type UpdateRecipientReq struct {
+		Name  string `json:"name"`
+		Email string `json:"email,omitempty"`
+	}
+	url := "https://api.paystack.co/transferrecipient/" + idOrCode
+	reqBody := &UpdateRecipientReq{Name: name, Email: email}
+	return c.request(ctx, url, "PUT", reqBody, nil)
+}
+
+// Deletes the transfer recipient with the given id or recipient code, removing their bank details from the integration.
+func (c *Client) DeleteRecipient(ctx context.Context, idOrCode string) error {
+	url := "https://api.paystack.co/transferrecipient/" + idOrCode
+	return c.request(ctx, url, "DELETE", nil, nil)
+}
@@ -0,0 +1,123 @@
+package paystack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// The transaction a refund was made against. Paystack returns either just its id or the full transaction depending on the endpoint.
+type RefundTransaction struct {
+	Id        int    `json:"id"`
+	Reference string `json:"reference"`
+}
+
+func (t *RefundTransaction) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] != '{' {
+		if bytes.Equal(data, []byte("null")) {
+			return nil
+		}
+		return json.Unmarshal(data, &t.Id)
+	}
+	type refundTransaction RefundTransaction
+	return json.Unmarshal(data, (*refundTransaction)(t))
+}
+
+// The processing state of a refund.
+type RefundStatus string
+
+const (
+	RefundPending    RefundStatus = "pending"
+	RefundProcessing RefundStatus = "processing"
+	RefundProcessed  RefundStatus = "processed"
+	RefundFailed     RefundStatus = "failed"
+)
+
+type Refund struct {
+	Id          int                `json:"id"`
+	Transaction *RefundTransaction `json:"transaction"`
+	Status      RefundStatus       `json:"status"`
+	Amount      Amount             `json:"amount"`
+	// How much of the refund has been deducted from the integration's balance so far.
+	DeductedAmount Amount     `json:"deducted_amount"`
+	FullyDeducted  bool       `json:"fully_deducted"`
+	ExpectedAt     *time.Time `json:"expected_at"`
+	Currency       string     `json:"currency"`
+	Channel        string     `json:"channel"`
+	Domain         string     `json:"domain"`
+	CustomerNote   string     `json:"customer_note"`
+	MerchantNote   string     `json:"merchant_note"`
+	RefundedBy     string     `json:"refunded_by"`
+	RefundedAt     *time.Time `json:"refunded_at"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+type NewRefund struct {
+	// The id or reference of the transaction to refund.
+	Transaction string `json:"transaction"`
+	// The amount to refund for partial refunds, in the smallest unit. Zero refunds the full transaction amount.
+	Amount       int64  `json:"amount,omitempty"`
+	Currency     string `json:"currency,omitempty"`
+	CustomerNote string `json:"customer_note,omitempty"`
+	MerchantNote string `json:"merchant_note,omitempty"`
+}
+
+// Refunds all or part of a transaction.
+func (c *Client) CreateRefund(ctx context.Context, refund *NewRefund) (*Refund, error) {
+	type CreateRefundResp struct {
+		Data *Refund `json:"data"`
+	}
+	url := "https://api.paystack.co/refund"
+	respBody := &CreateRefundResp{}
+	if err := c.request(ctx, url, "POST", refund, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type ListRefundsOptions struct {
+	ListOptions
+	// Only include refunds of the transaction with this id or reference.
+	Transaction string
+	// Only include refunds in this currency.
+	Currency string
+}
+
+// Lists the refunds made on the integration, optionally filtered by transaction, currency and date.
+func (c *Client) ListRefunds(ctx context.Context, opts *ListRefundsOptions) ([]*Refund, *Meta, error) {
+	type ListRefundsResp struct {
+		Data []*Refund `json:"data"`
+		Meta *Meta     `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListRefundsOptions{}
+	}
+	q := opts.values()
+	if opts.Transaction != "" {
+		q.Set("transaction", opts.Transaction)
+	}
+	if opts.Currency != "" {
+		q.Set("currency", opts.Currency)
+	}
+	url := withQuery("https://api.paystack.co/refund", q)
+	respBody := &ListRefundsResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the refund with the given id.
+func (c *Client) FetchRefund(ctx context.Context, id int) (*Refund, error) {
+	type FetchRefundResp struct {
+		Data *Refund `json:"data"`
+	}
+	url := "https://api.paystack.co/refund/" + strconv.Itoa(id)
+	respBody := &FetchRefundResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
@@ -0,0 +1,71 @@
+package paystack
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// Decides whether and when a failed request to Paystack is retried. Requests are not retried unless a policy is set with WithRetryPolicy.
+type RetryPolicy interface {
+	// Reports whether req should be sent again after its attempt-th try, counting from 1, returned resp or err.
+	// Resp is nil when err is set. Responses with a 2xx status are passed too, so a policy could retry those as well.
+	ShouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool
+	// Returns how long to wait before the next try after the attempt-th one failed.
+	NextDelay(attempt int) time.Duration
+}
+
+// Retries requests using the given policy.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// A RetryPolicy that retries network errors, rate limiting and server errors with exponentially growing delays.
+// Requests that are not idempotent, such as POSTs that create charges or transfers, are only retried when rate limited, since Paystack did not process them.
+type ExponentialBackoff struct {
+	// Total number of tries, including the first. Defaults to 3.
+	MaxAttempts int
+	// Delay before the second try, doubling for every try after. Defaults to 500ms.
+	BaseDelay time.Duration
+	// Upper bound on the delay between tries. Defaults to 10s.
+	MaxDelay time.Duration
+}
+
+func (b *ExponentialBackoff) ShouldRetry(req *http.Request, resp *http.Response, err error, attempt int) bool {
+	maxAttempts := b.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 3
+	}
+	if attempt >= maxAttempts {
+		return false
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+	default:
+		return false
+	}
+	if err != nil {
+		return !errors.Is(err, req.Context().Err())
+	}
+	return resp.StatusCode >= 500
+}
+
+func (b *ExponentialBackoff) NextDelay(attempt int) time.Duration {
+	delay := b.BaseDelay
+	if delay <= 0 {
+		delay = 500 * time.Millisecond
+	}
+	maxDelay := b.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 10 * time.Second
+	}
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,75 @@
+package paystack
+
+import (
+	"context"
+	"sort"
+)
+
+type ReconcileSubscriptionsOptions struct {
+	// Only reconcile subscriptions to the plan with this id.
+	PlanId int
+	// Only reconcile subscriptions of the customers with these ids. All customers are included if empty.
+	CustomerIds []int
+}
+
+// The differences between Paystack's subscriptions and a local snapshot of them.
+type SubscriptionDrift struct {
+	// Subscriptions that are active on Paystack but cancelled or missing locally, so customers may be charged for something they cancelled.
+	ActiveRemotely []*Subscription
+	// Codes of subscriptions that are active locally but cancelled or missing on Paystack, so customers may have access they no longer pay for.
+	// Non-renewing subscriptions still grant access until their period ends, so they are not reported here.
+	ActiveLocally []string
+}
+
+// Lists the subscriptions matching opts and compares them with local, which maps subscription codes to whether they are active locally.
+// Local should only hold subscriptions matching opts, since any others are reported as missing on Paystack.
+// Subscriptions are active on Paystack when Subscription.Active reports true, so cancelled subscriptions that are non-renewing never count as drift.
+// Both lists in the result are sorted by subscription code.
+func (c *Client) ReconcileSubscriptions(ctx context.Context, opts *ReconcileSubscriptionsOptions, local map[string]bool) (*SubscriptionDrift, error) {
+	if opts == nil {
+		opts = &ReconcileSubscriptionsOptions{}
+	}
+	filters := []*ListSubscriptionsOptions{}
+	if len(opts.CustomerIds) == 0 {
+		filters = append(filters, &ListSubscriptionsOptions{PlanId: opts.PlanId})
+	}
+	for _, customerId := range opts.CustomerIds {
+		filters = append(filters, &ListSubscriptionsOptions{PlanId: opts.PlanId, CustomerId: customerId})
+	}
+
+	remote := map[string]*Subscription{}
+	for _, filter := range filters {
+		filter.PerPage = 100
+		filter.Page = 1
+		for {
+			subscriptions, meta, err := c.ListSubscriptions(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			for _, subscription := range subscriptions {
+				remote[subscription.SubscriptionCode] = subscription
+			}
+			if meta == nil || filter.Page >= meta.PageCount || len(subscriptions) == 0 {
+				break
+			}
+			filter.Page++
+		}
+	}
+
+	drift := &SubscriptionDrift{}
+	for code, subscription := range remote {
+		if subscription.Active() && !local[code] {
+			drift.ActiveRemotely = append(drift.ActiveRemotely, subscription)
+		}
+	}
+	for code, active := range local {
+		if subscription, ok := remote[code]; active && (!ok || !subscription.Active() && !subscription.NonRenewing()) {
+			drift.ActiveLocally = append(drift.ActiveLocally, code)
+		}
+	}
+	sort.Slice(drift.ActiveRemotely, func(i, j int) bool {
+		return drift.ActiveRemotely[i].SubscriptionCode < drift.ActiveRemotely[j].SubscriptionCode
+	})
+	sort.Strings(drift.ActiveLocally)
+	return drift, nil
+}
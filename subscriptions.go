@@ -0,0 +1,80 @@
+package paystack
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+type Subscription struct {
+	Id               int            `json:"id"`
+	Domain           string         `json:"domain"`
+	Status           string         `json:"status"`
+	SubscriptionCode string         `json:"subscription_code"`
+	EmailToken       string         `json:"email_token"`
+	Amount           Amount         `json:"amount"`
+	CronExpression   string         `json:"cron_expression"`
+	NextPaymentDate  *time.Time     `json:"next_payment_date"`
+	OpenInvoice      string         `json:"open_invoice"`
+	Plan             *Plan          `json:"plan"`
+	Authorization    *Authorization `json:"authorization"`
+	Customer         *Customer      `json:"customer"`
+	CreatedAt        time.Time      `json:"createdAt"`
+}
+
+// Reports whether the subscription will still charge the customer, i.e. it is active or needs attention after a failed payment.
+func (s *Subscription) Active() bool {
+	return s.Status == "active" || s.Status == "attention"
+}
+
+// Reports whether the customer cancelled the subscription, which then grants access until the current period ends but is not charged again.
+func (s *Subscription) NonRenewing() bool {
+	return s.Status == "non-renewing"
+}
+
+type ListSubscriptionsOptions struct {
+	PerPage int
+	Page    int
+	// Only include subscriptions to the plan with this id.
+	PlanId int
+	// Only include subscriptions of the customer with this id.
+	CustomerId int
+}
+
+// Lists the subscriptions on the integration, optionally filtered by plan and customer.
+func (c *Client) ListSubscriptions(ctx context.Context, opts *ListSubscriptionsOptions) ([]*Subscription, *Meta, error) {
+	type ListSubscriptionsResp struct {
+		Data []*Subscription `json:"data"`
+		Meta *Meta           `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListSubscriptionsOptions{}
+	}
+	q := (&ListOptions{PerPage: opts.PerPage, Page: opts.Page}).values()
+	if opts.PlanId != 0 {
+		q.Set("plan", strconv.Itoa(opts.PlanId))
+	}
+	if opts.CustomerId != 0 {
+		q.Set("customer", strconv.Itoa(opts.CustomerId))
+	}
+	url := withQuery("https://api.paystack.co/subscription", q)
+	respBody := &ListSubscriptionsResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the plan with the given id or code, serving it from the client's cache if one is configured.
+// A cached plan can be up to the cache's ttl out of date after it is updated.
+func (c *Client) FetchPlan(ctx context.Context, idOrCode string) (*Plan, error) {
+	type FetchPlanResp struct {
+		Data *Plan `json:"data"`
+	}
+	url := "https://api.paystack.co/plan/" + idOrCode
+	respBody := &FetchPlanResp{}
+	if err := c.cachedRequest(ctx, url, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
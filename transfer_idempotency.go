@@ -0,0 +1,77 @@
+package paystack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Returns a reference derived from the given business keys, e.g. an order id and payout attempt, so initiating the same logical transfer again reuses its reference.
+// The keys are hashed, so the reference only contains characters Paystack accepts and leaks none of the keys.
+func TransferReference(prefix string, keys ...string) string {
+	h := sha256.New()
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+	}
+	return prefix + "_" + hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// Returns a copy of transfer whose reference is derived from keys if it has none, so every helper derives the same reference from the same keys.
+func withTransferReference(transfer *NewTransfer, keys []string) (*NewTransfer, error) {
+	withRef := *transfer
+	if withRef.Reference == "" {
+		if len(keys) == 0 {
+			return nil, &ValidationError{Errors: []FieldError{{Field: "reference", Message: "is required when no business keys are given"}}}
+		}
+		withRef.Reference = TransferReference("transfer", keys...)
+	}
+	return &withRef, nil
+}
+
+// Returned by InitiateTransferOnce when a transfer with the reference already exists but pays a different amount, recipient or currency.
+var ErrTransferMismatch = errors.New("paystack: existing transfer with the same reference does not match")
+
+// Initiates the transfer unless one with the same reference already exists, in which case the existing transfer is returned instead of an error.
+// The reference is derived from keys with TransferReference if the transfer has none, so retrying after a timeout or crash is safe without storing the reference first.
+// Returns a *ValidationError without calling Paystack if the transfer has neither a reference nor keys,
+// and an error wrapping ErrTransferMismatch if the existing transfer's amount, recipient or currency differ from transfer's.
+func (c *Client) InitiateTransferOnce(ctx context.Context, transfer *NewTransfer, keys ...string) (*Transfer, error) {
+	reqBody, err := withTransferReference(transfer, keys)
+	if err != nil {
+		return nil, err
+	}
+	initiated, err := c.InitiateTransfer(ctx, reqBody)
+	if err == nil {
+		return &Transfer{
+			Id:           initiated.Id,
+			TransferCode: initiated.TransferCode,
+			Reference:    initiated.Reference,
+			Status:       initiated.Status,
+			Amount:       initiated.Amount,
+			Currency:     initiated.Currency,
+			Source:       initiated.Source,
+			Reason:       initiated.Reason,
+			CreatedAt:    initiated.CreatedAt,
+		}, nil
+	}
+	if !IsDuplicateReference(err) {
+		return nil, err
+	}
+	existing, err := c.VerifyTransfer(ctx, reqBody.Reference)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case int64(existing.Amount) != reqBody.Amount:
+		return nil, fmt.Errorf("%w: %s pays %d instead of %d", ErrTransferMismatch, reqBody.Reference, existing.Amount, reqBody.Amount)
+	case reqBody.Currency != "" && !strings.EqualFold(existing.Currency, reqBody.Currency):
+		return nil, fmt.Errorf("%w: %s pays in %s instead of %s", ErrTransferMismatch, reqBody.Reference, existing.Currency, reqBody.Currency)
+	case existing.Recipient != nil && existing.Recipient.RecipientCode != reqBody.Recipient:
+		return nil, fmt.Errorf("%w: %s pays %s instead of %s", ErrTransferMismatch, reqBody.Reference, existing.Recipient.RecipientCode, reqBody.Recipient)
+	}
+	return existing, nil
+}
@@ -0,0 +1,219 @@
+package paystack
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+type NewTransfer struct {
+	// Where the funds are taken from. Defaults to "balance".
+	Source string `json:"source"`
+	// Amount is in the smallest unit, e.g. kobo instead of NGN.
+	Amount    int64  `json:"amount"`
+	Recipient string `json:"recipient"`
+	Reason    string `json:"reason,omitempty"`
+	Currency  string `json:"currency,omitempty"`
+	Reference string `json:"reference,omitempty"`
+}
+
+type InitiatedTransfer struct {
+	Id           int       `json:"id"`
+	TransferCode string    `json:"transfer_code"`
+	Reference    string    `json:"reference"`
+	Status       string    `json:"status"`
+	Amount       Amount    `json:"amount"`
+	Currency     string    `json:"currency"`
+	Source       string    `json:"source"`
+	Reason       string    `json:"reason"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// Initiates a transfer to the recipient with the given recipient code.
+// The returned status is "otp" if the transfer still needs to be finalized with an OTP.
+func (c *Client) InitiateTransfer(ctx context.Context, transfer *NewTransfer) (*InitiatedTransfer, error) {
+	type InitiateTransferResp struct {
+		Data *InitiatedTransfer `json:"data"`
+	}
+	reqBody := *transfer
+	if reqBody.Source == "" {
+		reqBody.Source = "balance"
+	}
+	url := "https://api.paystack.co/transfer"
+	respBody := &InitiateTransferResp{}
+	if err := c.request(ctx, url, "POST", &reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Finalizes a transfer that is awaiting OTP confirmation, using the OTP sent to the integration's business phone.
+func (c *Client) FinalizeTransfer(ctx context.Context, transferCode string, otp string) (*InitiatedTransfer, error) {
+	type FinalizeTransferReq struct {
+		TransferCode string `json:"transfer_code"`
+		Otp          string `json:"otp"`
+	}
+	type FinalizeTransferResp struct {
+		Data *InitiatedTransfer `json:"data"`
+	}
+	url := "https://api.paystack.co/transfer/finalize_transfer"
+	reqBody := &FinalizeTransferReq{TransferCode: transferCode, Otp: otp}
+	respBody := &FinalizeTransferResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type BulkTransferItem struct {
+	// Amount is in the smallest unit, e.g. kobo instead of NGN.
+	Amount    int64  `json:"amount"`
+	Recipient string `json:"recipient"`
+	Reference string `json:"reference,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type BulkTransferResult struct {
+	Reference    string `json:"reference"`
+	Recipient    string `json:"recipient"`
+	Amount       Amount `json:"amount"`
+	TransferCode string `json:"transfer_code"`
+	Currency     string `json:"currency"`
+	Status       string `json:"status"`
+}
+
+type bulkTransferReq struct {
+	Source    string              `json:"source"`
+	Currency  string              `json:"currency,omitempty"`
+	Transfers []*BulkTransferItem `json:"transfers"`
+}
+
+// Initiates multiple transfers from the integration's balance in a single request.
+// OTP must be disabled on the integration for bulk transfers to be processed.
+func (c *Client) BulkTransfer(ctx context.Context, currency string, transfers []*BulkTransferItem) ([]*BulkTransferResult, error) {
+	type BulkTransferResp struct {
+		Data []*BulkTransferResult `json:"data"`
+	}
+	url := "https://api.paystack.co/transfer/bulk"
+	reqBody := &bulkTransferReq{Source: "balance", Currency: currency, Transfers: transfers}
+	respBody := &BulkTransferResp{}
+	if err := c.request(ctx, url, "POST", reqBody, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type TransferSession struct {
+	Provider string `json:"provider"`
+	Id       string `json:"id"`
+}
+
+type Transfer struct {
+	Id           int        `json:"id"`
+	TransferCode string     `json:"transfer_code"`
+	Reference    string     `json:"reference"`
+	Status       string     `json:"status"`
+	Amount       Amount     `json:"amount"`
+	Currency     string     `json:"currency"`
+	Source       string     `json:"source"`
+	Reason       string     `json:"reason"`
+	Domain       string     `json:"domain"`
+	Recipient    *Recipient `json:"recipient"`
+	// The processor's explanation when a transfer fails or is reversed.
+	GatewayResponse string           `json:"gateway_response"`
+	Session         *TransferSession `json:"session"`
+	TransferredAt   *time.Time       `json:"transferred_at"`
+	CreatedAt       time.Time        `json:"createdAt"`
+	UpdatedAt       time.Time        `json:"updatedAt"`
+}
+
+type ListTransfersOptions struct {
+	ListOptions
+	// Only include transfers with this status, e.g. "success" or "failed".
+	Status string
+	// Only include transfers to the recipient with this id.
+	RecipientId int
+}
+
+// Lists the transfers made from the integration, optionally filtered by status, recipient and date.
+func (c *Client) ListTransfers(ctx context.Context, opts *ListTransfersOptions) ([]*Transfer, *Meta, error) {
+	type ListTransfersResp struct {
+		Data []*Transfer `json:"data"`
+		Meta *Meta       `json:"meta"`
+	}
+	if opts == nil {
+		opts = &ListTransfersOptions{}
+	}
+	q := opts.values()
+	if opts.Status != "" {
+		q.Set("status", opts.Status)
+	}
+	if opts.RecipientId != 0 {
+		q.Set("recipient", strconv.Itoa(opts.RecipientId))
+	}
+	url := withQuery("https://api.paystack.co/transfer", q)
+	respBody := &ListTransfersResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, nil, err
+	}
+	return respBody.Data, respBody.Meta, nil
+}
+
+// Fetches the full details of the transfer with the given id or transfer code, including its recipient and session.
+func (c *Client) FetchTransfer(ctx context.Context, idOrCode string) (*Transfer, error) {
+	type FetchTransferResp struct {
+		Data *Transfer `json:"data"`
+	}
+	url := "https://api.paystack.co/transfer/" + idOrCode
+	respBody := &FetchTransferResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Verifies the transfer with the given reference. The returned status could be "success", "failed", "reversed", or anything else indicating its pending.
+func (c *Client) VerifyTransfer(ctx context.Context, ref string) (*Transfer, error) {
+	type VerifyTransferResp struct {
+		Data *Transfer `json:"data"`
+	}
+	url := "https://api.paystack.co/transfer/verify/" + ref
+	respBody := &VerifyTransferResp{}
+	if err := c.request(ctx, url, "GET", nil, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+// Resends the OTP for a transfer awaiting finalization. Reason is either "resend_otp" or "transfer".
+func (c *Client) ResendTransferOtp(ctx context.Context, transferCode string, reason string) error {
+	type ResendTransferOtpReq struct {
+		TransferCode string `json:"transfer_code"`
+		Reason       string `json:"reason"`
+	}
+	url := "https://api.paystack.co/transfer/resend_otp"
+	reqBody := &ResendTransferOtpReq{TransferCode: transferCode, Reason: reason}
+	return c.request(ctx, url, "POST", reqBody, nil)
+}
+
+// Starts disabling OTP confirmation for transfers. Paystack sends an OTP to the business phone, which must be passed to FinalizeDisableTransferOtp.
+func (c *Client) DisableTransferOtp(ctx context.Context) error {
+	url := "https://api.paystack.co/transfer/disable_otp"
+	return c.request(ctx, url, "POST", nil, nil)
+}
+
+// Completes disabling OTP confirmation for transfers using the OTP sent by DisableTransferOtp.
+func (c *Client) FinalizeDisableTransferOtp(ctx context.Context, otp string) error {
+	type FinalizeDisableTransferOtpReq struct {
+		Otp string `json:"otp"`
+	}
+	url := "https://api.paystack.co/transfer/disable_otp_finalize"
+	reqBody := &FinalizeDisableTransferOtpReq{Otp: otp}
+	return c.request(ctx, url, "POST", reqBody, nil)
+}
+
+// Re-enables OTP confirmation for transfers.
+func (c *Client) EnableTransferOtp(ctx context.Context) error {
+	url := "https://api.paystack.co/transfer/enable_otp"
+	return c.request(ctx, url, "POST", nil, nil)
+}
@@ -0,0 +1,35 @@
+package paystack
+
+import (
+	"net/http"
+	"time"
+)
+
+// Sends requests with client instead of the Client's own transport. The transport options below have no effect on it.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// Sets how many idle keep-alive connections to api.paystack.co are kept open. High-volume workers should raise this from Go's default of 2 so connections are reused instead of exhausting ephemeral ports.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(c *Client) {
+		c.transport.MaxIdleConnsPerHost = n
+		c.transport.MaxIdleConns = max(c.transport.MaxIdleConns, n)
+	}
+}
+
+// Sets how long an idle keep-alive connection is kept open before it is closed.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.transport.IdleConnTimeout = timeout
+	}
+}
+
+// Sets whether responses are requested gzip compressed. Compression is enabled by default.
+func WithCompression(enabled bool) Option {
+	return func(c *Client) {
+		c.transport.DisableCompression = !enabled
+	}
+}
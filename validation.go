@@ -0,0 +1,246 @@
+package paystack
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// The largest metadata, once encoded as JSON, that this package sends to Paystack.
+const maxMetadataSize = 50 << 10
+
+// A problem with one field of a request.
+type FieldError struct {
+	// The JSON name of the field, e.g. "amount" or "transfers[2].recipient".
+	Field   string
+	Message string
+}
+
+// Returned without calling Paystack when a request is missing required fields or has invalid values.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Errors))
+	for i, fieldErr := range e.Errors {
+		problems[i] = fieldErr.Field + " " + fieldErr.Message
+	}
+	return "paystack: invalid request: " + strings.Join(problems, "; ")
+}
+
+// Implemented by request bodies that are checked before they are sent to Paystack.
+type validator interface {
+	validate() error
+}
+
+// Collects the problems found while validating a request body.
+type checks struct {
+	errors []FieldError
+}
+
+func (c *checks) add(field string, message string) {
+	c.errors = append(c.errors, FieldError{Field: field, Message: message})
+}
+
+func (c *checks) required(field string, value string) {
+	if value == "" {
+		c.add(field, "is required")
+	}
+}
+
+func (c *checks) email(field string, email string) {
+	if email == "" {
+		c.add(field, "is required")
+		return
+	}
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		c.add(field, "is not a valid email address")
+	}
+}
+
+func (c *checks) positiveAmount(field string, amount int64) {
+	if amount <= 0 {
+		c.add(field, "must be positive")
+	}
+}
+
+// Accepts empty currencies, which Paystack replaces with the integration's default.
+func (c *checks) currency(field string, currency string) {
+	if currency == "" {
+		return
+	}
+	if _, err := lookupCurrency(currency); err != nil {
+		c.add(field, "is not a supported currency")
+	}
+}
+
+// Accepts empty references, which Paystack generates itself.
+func (c *checks) reference(field string, ref string) {
+	for _, r := range ref {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '.' || r == '=' || r == '_') {
+			c.add(field, "may only contain letters, digits and - . = _")
+			return
+		}
+	}
+}
+
+func (c *checks) metadata(field string, metadata any) {
+	if metadata == nil {
+		return
+	}
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		c.add(field, "cannot be encoded as JSON")
+		return
+	}
+	if len(encoded) > maxMetadataSize {
+		c.add(field, fmt.Sprintf("is %d bytes, more than the %d allowed", len(encoded), maxMetadataSize))
+	}
+}
+
+func (c *checks) err() error {
+	if len(c.errors) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: c.errors}
+}
+
+func (r *Customer) validate() error {
+	c := &checks{}
+	c.email("email", r.Email)
+	return c.err()
+}
+
+func (r *NewRecipient) validate() error {
+	c := &checks{}
+	c.required("name", r.Name)
+	c.currency("currency", r.Currency)
+	c.metadata("metadata", r.Metadata)
+	switch r.Type {
+	case RecipientNuban, RecipientBasa, RecipientGhipss, RecipientMobileMoney:
+		c.required("account_number", r.AccountNumber)
+		c.required("bank_code", r.BankCode)
+	case RecipientAuthorization:
+		c.required("authorization_code", r.AuthorizationCode)
+		c.email("email", r.Email)
+	default:
+		c.add("type", fmt.Sprintf("%q is not a supported recipient type", r.Type))
+	}
+	return c.err()
+}
+
+func (t *NewTransfer) validate() error {
+	c := &checks{}
+	c.required("recipient", t.Recipient)
+	c.positiveAmount("amount", t.Amount)
+	c.currency("currency", t.Currency)
+	c.reference("reference", t.Reference)
+	return c.err()
+}
+
+func (t *bulkTransferReq) validate() error {
+	c := &checks{}
+	c.currency("currency", t.Currency)
+	if len(t.Transfers) == 0 {
+		c.add("transfers", "must not be empty")
+	}
+	for i, transfer := range t.Transfers {
+		field := fmt.Sprintf("transfers[%d]", i)
+		if transfer == nil {
+			c.add(field, "is required")
+			continue
+		}
+		c.required(field+".recipient", transfer.Recipient)
+		c.positiveAmount(field+".amount", transfer.Amount)
+		c.reference(field+".reference", transfer.Reference)
+	}
+	return c.err()
+}
+
+func (items bulkChargeItems) validate() error {
+	c := &checks{}
+	if len(items) == 0 {
+		c.add("charges", "must not be empty")
+	}
+	for i, item := range items {
+		field := fmt.Sprintf("charges[%d]", i)
+		if item == nil {
+			c.add(field, "is required")
+			continue
+		}
+		c.required(field+".authorization", item.Authorization)
+		c.positiveAmount(field+".amount", item.Amount)
+		c.reference(field+".reference", item.Reference)
+	}
+	return c.err()
+}
+
+func (r *createChargeReq) validate() error {
+	c := &checks{}
+	c.email("email", r.Email)
+	c.positiveAmount("amount", r.Amount)
+	c.currency("currency", r.Currency)
+	c.reference("reference", r.Reference)
+	c.metadata("metadata", r.Metadata)
+	if r.MobileMoney != nil && !r.MobileMoney.Provider.Valid() {
+		c.add("mobile_money.provider", fmt.Sprintf("%q is not a supported mobile money provider", r.MobileMoney.Provider))
+	}
+	if r.Ussd != nil && !r.Ussd.Type.Valid() {
+		c.add("ussd.type", fmt.Sprintf("%q is not a supported ussd type", r.Ussd.Type))
+	}
+	if r.Qr != nil && !r.Qr.Provider.Valid() {
+		c.add("qr.provider", fmt.Sprintf("%q is not a supported qr provider", r.Qr.Provider))
+	}
+	return c.err()
+}
+
+func (r *NewRefund) validate() error {
+	c := &checks{}
+	c.required("transaction", r.Transaction)
+	if r.Amount < 0 {
+		c.add("amount", "must not be negative")
+	}
+	c.currency("currency", r.Currency)
+	return c.err()
+}
+
+func (r *initTransactionReq) validate() error {
+	c := &checks{}
+	c.email("email", r.Email)
+	c.positiveAmount("amount", r.amount)
+	if split := r.TransactionSplit; split != nil {
+		if (split.Subaccount == "") == (split.SplitCode == "") {
+			c.add("subaccount", "exactly one of subaccount and split_code is required")
+		}
+		if split.Bearer != "" && !split.Bearer.Valid() {
+			c.add("bearer", fmt.Sprintf("%q is not a supported bearer", split.Bearer))
+		}
+		if split.TransactionCharge < 0 {
+			c.add("transaction_charge", "must not be negative")
+		}
+	}
+	return c.err()
+}
+
+func (r *chargeAuthorizationReq) validate() error {
+	c := &checks{}
+	c.email("email", r.Email)
+	c.positiveAmount("amount", r.amount)
+	c.required("authorization_code", r.AuthorizationCode)
+	return c.err()
+}
+
+func (r *NewMandate) validate() error {
+	c := &checks{}
+	c.email("email", r.Email)
+	if r.Account != nil {
+		c.required("account.number", r.Account.Number)
+		c.required("account.bank_code", r.Account.BankCode)
+		if r.Address == nil {
+			c.add("address", "is required when an account is given")
+		}
+	}
+	return c.err()
+}
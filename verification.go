@@ -0,0 +1,82 @@
+package paystack
+
+import (
+	"context"
+	"net/url"
+)
+
+type ResolvedAccount struct {
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+	BankId        int    `json:"bank_id"`
+}
+
+// Resolves the account number at the bank with the given code, returning the name the account is registered to.
+func (c *Client) ResolveAccount(ctx context.Context, accountNumber string, bankCode string) (*ResolvedAccount, error) {
+	type ResolveAccountResp struct {
+		Data *ResolvedAccount `json:"data"`
+	}
+	q := url.Values{}
+	q.Set("account_number", accountNumber)
+	q.Set("bank_code", bankCode)
+	u := withQuery("https://api.paystack.co/bank/resolve", q)
+	respBody := &ResolveAccountResp{}
+	if err := c.cachedRequest(ctx, u, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type AccountValidation struct {
+	BankCode      string `json:"bank_code"`
+	CountryCode   string `json:"country_code"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+	// Either "personal" or "business".
+	AccountType string `json:"account_type"`
+	// Either "identityNumber", "passportNumber" or "businessRegistrationNumber".
+	DocumentType   string `json:"document_type"`
+	DocumentNumber string `json:"document_number"`
+}
+
+type ValidatedAccount struct {
+	Verified            bool   `json:"verified"`
+	VerificationMessage string `json:"verificationMessage"`
+}
+
+// Confirms that the account belongs to the given account holder. Required for South African accounts before paying out to them.
+func (c *Client) ValidateAccount(ctx context.Context, account *AccountValidation) (*ValidatedAccount, error) {
+	type ValidateAccountResp struct {
+		Data *ValidatedAccount `json:"data"`
+	}
+	u := "https://api.paystack.co/bank/validate"
+	respBody := &ValidateAccountResp{}
+	if err := c.request(ctx, u, "POST", account, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
+
+type CardBin struct {
+	Bin          string `json:"bin"`
+	Brand        string `json:"brand"`
+	SubBrand     string `json:"sub_brand"`
+	CardType     string `json:"card_type"`
+	Bank         string `json:"bank"`
+	CountryCode  string `json:"country_code"`
+	CountryName  string `json:"country_name"`
+	LinkedBankId int    `json:"linked_bank_id"`
+}
+
+// Returns the brand, type, issuing bank and country of cards starting with the given bin, i.e. their first 6 digits.
+func (c *Client) ResolveBin(ctx context.Context, bin string) (*CardBin, error) {
+	type ResolveBinResp struct {
+		Data *CardBin `json:"data"`
+	}
+	u := "https://api.paystack.co/decision/bin/" + bin
+	respBody := &ResolveBinResp{}
+	if err := c.cachedRequest(ctx, u, respBody); err != nil {
+		return nil, err
+	}
+	return respBody.Data, nil
+}
@@ -0,0 +1,96 @@
+package paystack
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Reports whether signatureHeader, the value of the x-paystack-signature header, is the HMAC-SHA512 of payload keyed with the integration's secret key.
+// Payload must be the raw request body, exactly as received. Always false if secret is empty, so an unset secret does not accept payloads anyone can sign.
+func VerifyWebhookSignature(payload []byte, signatureHeader string, secret string) bool {
+	if secret == "" {
+		return false
+	}
+	signature, err := hex.DecodeString(signatureHeader)
+	if err != nil || len(signature) != sha512.Size {
+		return false
+	}
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
+
+// The kind of event a webhook notifies about.
+type EventType string
+
+const (
+	EventChargeSuccess                 EventType = "charge.success"
+	EventChargeDisputeCreate           EventType = "charge.dispute.create"
+	EventChargeDisputeRemind           EventType = "charge.dispute.remind"
+	EventChargeDisputeResolve          EventType = "charge.dispute.resolve"
+	EventCustomerIdentificationSuccess EventType = "customeridentification.success"
+	EventCustomerIdentificationFailed  EventType = "customeridentification.failed"
+	EventDedicatedAccountAssignSuccess EventType = "dedicatedaccount.assign.success"
+	EventDedicatedAccountAssignFailed  EventType = "dedicatedaccount.assign.failed"
+	EventInvoiceCreate                 EventType = "invoice.create"
+	EventInvoiceUpdate                 EventType = "invoice.update"
+	EventInvoicePaymentFailed          EventType = "invoice.payment_failed"
+	EventPaymentRequestPending         EventType = "paymentrequest.pending"
+	EventPaymentRequestSuccess         EventType = "paymentrequest.success"
+	EventRefundPending                 EventType = "refund.pending"
+	EventRefundProcessing              EventType = "refund.processing"
+	EventRefundProcessed               EventType = "refund.processed"
+	EventRefundFailed                  EventType = "refund.failed"
+	EventSubscriptionCreate            EventType = "subscription.create"
+	EventSubscriptionDisable           EventType = "subscription.disable"
+	EventSubscriptionNotRenew          EventType = "subscription.not_renew"
+	EventSubscriptionExpiringCards     EventType = "subscription.expiring_cards"
+	EventTransferSuccess               EventType = "transfer.success"
+	EventTransferFailed                EventType = "transfer.failed"
+	EventTransferReversed              EventType = "transfer.reversed"
+)
+
+// Reports whether t is one of the event types defined by this package.
+func (t EventType) Known() bool {
+	switch t {
+	case EventChargeSuccess, EventChargeDisputeCreate, EventChargeDisputeRemind, EventChargeDisputeResolve,
+		EventCustomerIdentificationSuccess, EventCustomerIdentificationFailed,
+		EventDedicatedAccountAssignSuccess, EventDedicatedAccountAssignFailed,
+		EventInvoiceCreate, EventInvoiceUpdate, EventInvoicePaymentFailed,
+		EventPaymentRequestPending, EventPaymentRequestSuccess,
+		EventRefundPending, EventRefundProcessing, EventRefundProcessed, EventRefundFailed,
+		EventSubscriptionCreate, EventSubscriptionDisable, EventSubscriptionNotRenew, EventSubscriptionExpiringCards,
+		EventTransferSuccess, EventTransferFailed, EventTransferReversed:
+		return true
+	}
+	return false
+}
+
+// A webhook notification. Data holds the event's payload as sent by Paystack.
+type Event struct {
+	Event EventType       `json:"event"`
+	Data  json.RawMessage `json:"data"`
+	// The full webhook body the event was parsed from.
+	Raw []byte `json:"-"`
+}
+
+// Parses a webhook request body into an event. The signature should already have been checked with VerifyWebhookSignature.
+func ParseEvent(payload []byte) (*Event, error) {
+	event := &Event{}
+	if err := json.Unmarshal(payload, event); err != nil {
+		return nil, err
+	}
+	event.Raw = payload
+	if event.Event == "" {
+		return nil, fmt.Errorf("webhook payload has no event type")
+	}
+	return event, nil
+}
+
+// Decodes the event's data into v.
+func (e *Event) Decode(v any) error {
+	return json.Unmarshal(e.Data, v)
+}
@@ -0,0 +1,88 @@
+package paystack
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Remembers which webhook events have been handled, so the WebhookHandler can skip events that Paystack redelivers.
+// Implementations must be safe for concurrent use.
+type DeduplicationStore interface {
+	// Atomically records the event with key as handled, reporting false if it was already claimed, so only one delivery of an event is handled.
+	Claim(ctx context.Context, key string) (bool, error)
+	// Forgets a claim whose handler failed, so a redelivery of the event is handled again.
+	Release(ctx context.Context, key string) error
+}
+
+// Claims each event's key in store before its handler runs, skipping events that were already claimed, and releases the claim if the handler fails.
+func WithDeduplication(store DeduplicationStore) WebhookOption {
+	return func(h *WebhookHandler) {
+		h.dedup = store
+	}
+}
+
+// Returns a key identifying the event across redeliveries, made from its type, the id or reference of its data and a hash of the data.
+// Redeliveries carry the same data, while later events about the same object, e.g. a second charge.dispute.remind, get a different key.
+func (e *Event) Key() string {
+	type EventIds struct {
+		Id        json.RawMessage `json:"id"`
+		Reference string          `json:"reference"`
+	}
+	sum := sha256.Sum256(e.Data)
+	hash := hex.EncodeToString(sum[:16])
+	ids := &EventIds{}
+	if err := e.Decode(ids); err == nil {
+		if len(ids.Id) > 0 && string(ids.Id) != "null" {
+			return string(e.Event) + ":" + string(ids.Id) + ":" + hash
+		}
+		if ids.Reference != "" {
+			return string(e.Event) + ":" + ids.Reference + ":" + hash
+		}
+	}
+	return string(e.Event) + ":" + hash
+}
+
+// An in-memory DeduplicationStore that forgets keys after a ttl. Keys are lost when the process restarts.
+// Expired keys are swept once the store has doubled in size since the last sweep, so claiming costs amortised constant time.
+type MemoryDeduplicationStore struct {
+	ttl  time.Duration
+	mu   sync.Mutex
+	seen map[string]time.Time
+	// The number of keys at which expired ones are next swept.
+	sweepAt int
+}
+
+// Creates an in-memory deduplication store that remembers keys for ttl.
+func NewMemoryDeduplicationStore(ttl time.Duration) *MemoryDeduplicationStore {
+	return &MemoryDeduplicationStore{ttl: ttl, seen: map[string]time.Time{}, sweepAt: minSweepSize}
+}
+
+func (m *MemoryDeduplicationStore) Claim(ctx context.Context, key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if len(m.seen) >= m.sweepAt {
+		for key, claimedAt := range m.seen {
+			if now.Sub(claimedAt) > m.ttl {
+				delete(m.seen, key)
+			}
+		}
+		m.sweepAt = max(2*len(m.seen), minSweepSize)
+	}
+	if claimedAt, ok := m.seen[key]; ok && now.Sub(claimedAt) <= m.ttl {
+		return false, nil
+	}
+	m.seen[key] = now
+	return true, nil
+}
+
+func (m *MemoryDeduplicationStore) Release(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.seen, key)
+	return nil
+}
@@ -0,0 +1,167 @@
+package paystack
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// The data of a charge.success event.
+type ChargeSuccessData struct {
+	Id              int             `json:"id"`
+	Domain          string          `json:"domain"`
+	Status          string          `json:"status"`
+	Reference       string          `json:"reference"`
+	Amount          Amount          `json:"amount"`
+	RequestedAmount Amount          `json:"requested_amount"`
+	Fees            Amount          `json:"fees"`
+	Currency        string          `json:"currency"`
+	Channel         string          `json:"channel"`
+	Message         string          `json:"message"`
+	GatewayResponse string          `json:"gateway_response"`
+	IpAddress       string          `json:"ip_address"`
+	Metadata        json.RawMessage `json:"metadata"`
+	Customer        *Customer       `json:"customer"`
+	Authorization   *Authorization  `json:"authorization"`
+	PaidAt          time.Time       `json:"paid_at"`
+	CreatedAt       time.Time       `json:"created_at"`
+}
+
+// Wraps fn in an EventHandler that decodes the event's data into a T first.
+func typedHandler[T any](fn func(ctx context.Context, data *T) error) EventHandler {
+	return func(ctx context.Context, event *Event) error {
+		data := new(T)
+		if err := event.Decode(data); err != nil {
+			return err
+		}
+		return fn(ctx, data)
+	}
+}
+
+// The data of transfer.success, transfer.failed and transfer.reversed events.
+type TransferEventData struct {
+	Id           int        `json:"id"`
+	Domain       string     `json:"domain"`
+	Status       string     `json:"status"`
+	Reference    string     `json:"reference"`
+	TransferCode string     `json:"transfer_code"`
+	Amount       Amount     `json:"amount"`
+	Currency     string     `json:"currency"`
+	Source       string     `json:"source"`
+	Reason       string     `json:"reason"`
+	Recipient    *Recipient `json:"recipient"`
+	// Why a transfer failed or was reversed.
+	GatewayResponse string           `json:"gateway_response"`
+	Failures        json.RawMessage  `json:"failures"`
+	Session         *TransferSession `json:"session"`
+	TransferredAt   *time.Time       `json:"transferred_at"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+type Plan struct {
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	PlanCode     string `json:"plan_code"`
+	Amount       Amount `json:"amount"`
+	Currency     string `json:"currency"`
+	Interval     string `json:"interval"`
+	Description  string `json:"description"`
+	SendInvoices bool   `json:"send_invoices"`
+	SendSms      bool   `json:"send_sms"`
+	// How many times subscribers are charged. Zero charges them until they cancel.
+	InvoiceLimit int       `json:"invoice_limit"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// The data of subscription.create, subscription.disable and subscription.not_renew events.
+type SubscriptionEventData struct {
+	Domain           string         `json:"domain"`
+	Status           string         `json:"status"`
+	SubscriptionCode string         `json:"subscription_code"`
+	EmailToken       string         `json:"email_token"`
+	Amount           Amount         `json:"amount"`
+	CronExpression   string         `json:"cron_expression"`
+	NextPaymentDate  *time.Time     `json:"next_payment_date"`
+	OpenInvoice      string         `json:"open_invoice"`
+	Plan             *Plan          `json:"plan"`
+	Authorization    *Authorization `json:"authorization"`
+	Customer         *Customer      `json:"customer"`
+	CreatedAt        time.Time      `json:"created_at"`
+}
+
+// The subscription an invoice was raised for.
+type InvoiceSubscription struct {
+	Status           string     `json:"status"`
+	SubscriptionCode string     `json:"subscription_code"`
+	EmailToken       string     `json:"email_token"`
+	Amount           Amount     `json:"amount"`
+	CronExpression   string     `json:"cron_expression"`
+	NextPaymentDate  *time.Time `json:"next_payment_date"`
+	OpenInvoice      string     `json:"open_invoice"`
+}
+
+// The transaction an invoice was paid, or attempted to be paid, with.
+type InvoiceTransaction struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Amount    Amount `json:"amount"`
+	Currency  string `json:"currency"`
+}
+
+// The data of invoice.create, invoice.update and invoice.payment_failed events.
+type InvoiceEventData struct {
+	Domain        string               `json:"domain"`
+	InvoiceCode   string               `json:"invoice_code"`
+	Status        string               `json:"status"`
+	Description   string               `json:"description"`
+	Amount        Amount               `json:"amount"`
+	Paid          bool                 `json:"paid"`
+	PaidAt        *time.Time           `json:"paid_at"`
+	PeriodStart   time.Time            `json:"period_start"`
+	PeriodEnd     time.Time            `json:"period_end"`
+	Authorization *Authorization       `json:"authorization"`
+	Subscription  *InvoiceSubscription `json:"subscription"`
+	Customer      *Customer            `json:"customer"`
+	Transaction   *InvoiceTransaction  `json:"transaction"`
+	CreatedAt     time.Time            `json:"created_at"`
+}
+
+// The data of charge.dispute.create, charge.dispute.remind and charge.dispute.resolve events. DueAt is when the merchant must respond by.
+type DisputeEventData struct {
+	Dispute
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type DedicatedAccountAssignment struct {
+	AssigneeId   int        `json:"assignee_id"`
+	AssigneeType string     `json:"assignee_type"`
+	AccountType  string     `json:"account_type"`
+	Expired      bool       `json:"expired"`
+	AssignedAt   time.Time  `json:"assigned_at"`
+	ExpiredAt    *time.Time `json:"expired_at"`
+}
+
+type DedicatedAccount struct {
+	Id            int                         `json:"id"`
+	AccountName   string                      `json:"account_name"`
+	AccountNumber string                      `json:"account_number"`
+	Currency      string                      `json:"currency"`
+	Assigned      bool                        `json:"assigned"`
+	Active        bool                        `json:"active"`
+	Bank          *TransferBank               `json:"bank"`
+	Assignment    *DedicatedAccountAssignment `json:"assignment"`
+	CreatedAt     time.Time                   `json:"created_at"`
+	UpdatedAt     time.Time                   `json:"updated_at"`
+}
+
+// The data of dedicatedaccount.assign.success and dedicatedaccount.assign.failed events. DedicatedAccount is nil when assignment failed.
+type DedicatedAccountEventData struct {
+	Customer         *Customer         `json:"customer"`
+	DedicatedAccount *DedicatedAccount `json:"dedicated_account"`
+	Identification   struct {
+		Status string `json:"status"`
+	} `json:"identification"`
+}
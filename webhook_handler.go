@@ -0,0 +1,175 @@
+package paystack
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Handles a webhook event. Returning an error responds with a 500 so Paystack redelivers the event later.
+type EventHandler func(ctx context.Context, event *Event) error
+
+// An http.Handler that verifies webhook signatures, parses events and dispatches them to registered handlers.
+// Handlers run with the request's context before the response is written, so they should return quickly and hand slow work off elsewhere.
+// Events without a registered handler are acknowledged and dropped, except for event types this package does not know about, which go to the OnUnknown handler if set.
+type WebhookHandler struct {
+	secret   string
+	handlers map[EventType]EventHandler
+	unknown  EventHandler
+	dedup    DeduplicationStore
+}
+
+// Configures optional behaviour of a WebhookHandler.
+type WebhookOption func(*WebhookHandler)
+
+// Creates a webhook handler that verifies signatures with the integration's secret key. Panics if secret is empty.
+func NewWebhookHandler(secret string, opts ...WebhookOption) *WebhookHandler {
+	if secret == "" {
+		panic("paystack: webhook secret is empty")
+	}
+	h := &WebhookHandler{
+		secret:   secret,
+		handlers: map[EventType]EventHandler{},
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Registers fn to handle events of the given type, replacing any handler registered before.
+func (h *WebhookHandler) On(eventType EventType, fn EventHandler) {
+	h.handlers[eventType] = fn
+}
+
+// Registers fn to handle events whose type this package does not know about and that have no handler registered with On.
+// The event's Raw field holds the full webhook body so nothing Paystack sent is lost.
+func (h *WebhookHandler) OnUnknown(fn EventHandler) {
+	h.unknown = fn
+}
+
+// Registers fn to handle charge.success events.
+func (h *WebhookHandler) OnChargeSuccess(fn func(ctx context.Context, data *ChargeSuccessData) error) {
+	h.On(EventChargeSuccess, typedHandler(fn))
+}
+
+// Registers fn to handle transfer.success events.
+func (h *WebhookHandler) OnTransferSuccess(fn func(ctx context.Context, data *TransferEventData) error) {
+	h.On(EventTransferSuccess, typedHandler(fn))
+}
+
+// Registers fn to handle transfer.failed events.
+func (h *WebhookHandler) OnTransferFailed(fn func(ctx context.Context, data *TransferEventData) error) {
+	h.On(EventTransferFailed, typedHandler(fn))
+}
+
+// Registers fn to handle transfer.reversed events.
+func (h *WebhookHandler) OnTransferReversed(fn func(ctx context.Context, data *TransferEventData) error) {
+	h.On(EventTransferReversed, typedHandler(fn))
+}
+
+// Registers fn to handle subscription.create events.
+func (h *WebhookHandler) OnSubscriptionCreate(fn func(ctx context.Context, data *SubscriptionEventData) error) {
+	h.On(EventSubscriptionCreate, typedHandler(fn))
+}
+
+// Registers fn to handle subscription.disable events.
+func (h *WebhookHandler) OnSubscriptionDisable(fn func(ctx context.Context, data *SubscriptionEventData) error) {
+	h.On(EventSubscriptionDisable, typedHandler(fn))
+}
+
+// Registers fn to handle subscription.not_renew events.
+func (h *WebhookHandler) OnSubscriptionNotRenew(fn func(ctx context.Context, data *SubscriptionEventData) error) {
+	h.On(EventSubscriptionNotRenew, typedHandler(fn))
+}
+
+// Registers fn to handle invoice.create events.
+func (h *WebhookHandler) OnInvoiceCreate(fn func(ctx context.Context, data *InvoiceEventData) error) {
+	h.On(EventInvoiceCreate, typedHandler(fn))
+}
+
+// Registers fn to handle invoice.update events.
+func (h *WebhookHandler) OnInvoiceUpdate(fn func(ctx context.Context, data *InvoiceEventData) error) {
+	h.On(EventInvoiceUpdate, typedHandler(fn))
+}
+
+// Registers fn to handle invoice.payment_failed events.
+func (h *WebhookHandler) OnInvoicePaymentFailed(fn func(ctx context.Context, data *InvoiceEventData) error) {
+	h.On(EventInvoicePaymentFailed, typedHandler(fn))
+}
+
+// Registers fn to handle charge.dispute.create events.
+func (h *WebhookHandler) OnChargeDisputeCreate(fn func(ctx context.Context, data *DisputeEventData) error) {
+	h.On(EventChargeDisputeCreate, typedHandler(fn))
+}
+
+// Registers fn to handle charge.dispute.remind events.
+func (h *WebhookHandler) OnChargeDisputeRemind(fn func(ctx context.Context, data *DisputeEventData) error) {
+	h.On(EventChargeDisputeRemind, typedHandler(fn))
+}
+
+// Registers fn to handle charge.dispute.resolve events.
+func (h *WebhookHandler) OnChargeDisputeResolve(fn func(ctx context.Context, data *DisputeEventData) error) {
+	h.On(EventChargeDisputeResolve, typedHandler(fn))
+}
+
+// Registers fn to handle dedicatedaccount.assign.success events.
+func (h *WebhookHandler) OnDedicatedAccountAssignSuccess(fn func(ctx context.Context, data *DedicatedAccountEventData) error) {
+	h.On(EventDedicatedAccountAssignSuccess, typedHandler(fn))
+}
+
+// Registers fn to handle dedicatedaccount.assign.failed events.
+func (h *WebhookHandler) OnDedicatedAccountAssignFailed(fn func(ctx context.Context, data *DedicatedAccountEventData) error) {
+	h.On(EventDedicatedAccountAssignFailed, typedHandler(fn))
+}
+
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "could not read body", http.StatusBadRequest)
+		return
+	}
+	if !VerifyWebhookSignature(payload, r.Header.Get("x-paystack-signature"), h.secret) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	event, err := ParseEvent(payload)
+	if err != nil {
+		http.Error(w, "invalid event", http.StatusBadRequest)
+		return
+	}
+	fn, ok := h.handlers[event.Event]
+	if !ok && h.unknown != nil && !event.Event.Known() {
+		fn, ok = h.unknown, true
+	}
+	if !ok {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	key := ""
+	if h.dedup != nil {
+		key = event.Key()
+		claimed, err := h.dedup.Claim(r.Context(), key)
+		if err != nil {
+			http.Error(w, "deduplication failed", http.StatusInternalServerError)
+			return
+		}
+		if !claimed {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	if err := fn(r.Context(), event); err != nil {
+		if h.dedup != nil {
+			// Paystack redelivers the event after the 500, which must not be skipped as already handled.
+			_ = h.dedup.Release(context.WithoutCancel(r.Context()), key)
+		}
+		http.Error(w, "handler failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,46 @@
+package paystack_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/acudac-com/paystack-go"
+	"github.com/acudac-com/paystack-go/webhooktest"
+)
+
+func TestWebhookHandlerDeduplication(t *testing.T) {
+	const secret = "sk_test_secret"
+	calls := 0
+	fail := true
+	h := paystack.NewWebhookHandler(secret, paystack.WithDeduplication(paystack.NewMemoryDeduplicationStore(time.Hour)))
+	h.OnChargeSuccess(func(ctx context.Context, data *paystack.ChargeSuccessData) error {
+		calls++
+		if fail {
+			return errors.New("database unavailable")
+		}
+		return nil
+	})
+	data := map[string]any{"id": 1, "reference": "ref_1", "status": "success", "amount": 10000}
+	deliver := func(step string, wantStatus int, wantCalls int) {
+		t.Helper()
+		rec := webhooktest.Deliver(h, secret, paystack.EventChargeSuccess, data)
+		if rec.Code != wantStatus {
+			t.Errorf("%s: got status %d, want %d", step, rec.Code, wantStatus)
+		}
+		if calls != wantCalls {
+			t.Errorf("%s: handler called %d times, want %d", step, calls, wantCalls)
+		}
+	}
+
+	deliver("failed delivery", http.StatusInternalServerError, 1)
+	fail = false
+	deliver("redelivery after failure", http.StatusOK, 2)
+	deliver("duplicate after success", http.StatusOK, 2)
+
+	data["id"] = 2
+	data["reference"] = "ref_2"
+	deliver("other event", http.StatusOK, 3)
+}
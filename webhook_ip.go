@@ -0,0 +1,80 @@
+package paystack
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// The addresses Paystack sends webhooks from, as published in its documentation.
+var PaystackWebhookIPs = []string{
+	"52.31.139.75",
+	"52.49.173.169",
+	"52.214.14.220",
+}
+
+// Middleware that rejects requests not coming from an allowed address with a 403.
+type IPAllowlist struct {
+	// IP addresses or CIDR prefixes that are allowed. Defaults to PaystackWebhookIPs.
+	Allowed []string
+	// Use an address from the X-Forwarded-For header instead of the connection's address.
+	// Only enable this behind proxies that append the connecting address to the header, like nginx's $proxy_add_x_forwarded_for or AWS load balancers.
+	// Entries further left are set by the caller and can be spoofed, so they are never used.
+	TrustForwardedFor bool
+	// How many proxies in front of the server append to X-Forwarded-For. The address is taken this many entries from the right. Defaults to 1.
+	TrustedProxies int
+}
+
+// Returns a handler that only passes requests from allowed addresses through to next.
+// Panics if an allowed entry is neither an IP address nor a CIDR prefix.
+func (a *IPAllowlist) Wrap(next http.Handler) http.Handler {
+	allowed := a.Allowed
+	if allowed == nil {
+		allowed = PaystackWebhookIPs
+	}
+	prefixes := make([]netip.Prefix, len(allowed))
+	for i, entry := range allowed {
+		if strings.Contains(entry, "/") {
+			prefixes[i] = netip.MustParsePrefix(entry)
+			continue
+		}
+		addr := netip.MustParseAddr(entry)
+		prefixes[i] = netip.PrefixFrom(addr, addr.BitLen())
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr, ok := a.clientAddr(r)
+		if ok {
+			for _, prefix := range prefixes {
+				if prefix.Contains(addr) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+		}
+		http.Error(w, "forbidden", http.StatusForbidden)
+	})
+}
+
+func (a *IPAllowlist) clientAddr(r *http.Request) (netip.Addr, bool) {
+	host := r.RemoteAddr
+	if a.TrustForwardedFor {
+		hops := a.TrustedProxies
+		if hops <= 0 {
+			hops = 1
+		}
+		forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+		if len(forwarded) < hops {
+			return netip.Addr{}, false
+		}
+		host = strings.TrimSpace(forwarded[len(forwarded)-hops])
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
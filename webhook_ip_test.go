@@ -0,0 +1,49 @@
+package paystack
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestIPAllowlist(t *testing.T) {
+	tests := []struct {
+		name       string
+		allowlist  IPAllowlist
+		remoteAddr string
+		forwarded  []string
+		want       int
+	}{
+		{name: "allowed connection", allowlist: IPAllowlist{}, remoteAddr: "52.31.139.75:443", want: http.StatusOK},
+		{name: "other connection", allowlist: IPAllowlist{}, remoteAddr: "203.0.113.7:443", want: http.StatusForbidden},
+		{name: "forwarded header ignored unless trusted", allowlist: IPAllowlist{}, remoteAddr: "203.0.113.7:443", forwarded: []string{"52.31.139.75"}, want: http.StatusForbidden},
+		{name: "forwarded by proxy", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75"}, want: http.StatusOK},
+		{name: "spoofed left entry", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75, 203.0.113.7"}, want: http.StatusForbidden},
+		{name: "two proxies", allowlist: IPAllowlist{TrustForwardedFor: true, TrustedProxies: 2}, remoteAddr: "10.0.0.2:443", forwarded: []string{"203.0.113.7, 52.31.139.75, 10.0.0.1"}, want: http.StatusOK},
+		{name: "two proxies spoofed", allowlist: IPAllowlist{TrustForwardedFor: true, TrustedProxies: 2}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75, 203.0.113.7, 10.0.0.1"}, want: http.StatusForbidden},
+		{name: "two proxies split across headers", allowlist: IPAllowlist{TrustForwardedFor: true, TrustedProxies: 2}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75", "10.0.0.1"}, want: http.StatusOK},
+		{name: "fewer entries than proxies", allowlist: IPAllowlist{TrustForwardedFor: true, TrustedProxies: 2}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75"}, want: http.StatusForbidden},
+		{name: "missing header", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "52.31.139.75:443", want: http.StatusForbidden},
+		{name: "ipv4-mapped ipv6 connection", allowlist: IPAllowlist{}, remoteAddr: "[::ffff:52.31.139.75]:443", want: http.StatusOK},
+		{name: "ipv4-mapped ipv6 forwarded", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "10.0.0.2:443", forwarded: []string{"::ffff:52.31.139.75"}, want: http.StatusOK},
+		{name: "forwarded with port", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "10.0.0.2:443", forwarded: []string{"52.31.139.75:51234"}, want: http.StatusOK},
+		{name: "cidr entry", allowlist: IPAllowlist{Allowed: []string{"198.51.100.0/24"}}, remoteAddr: "198.51.100.42:443", want: http.StatusOK},
+		{name: "outside cidr entry", allowlist: IPAllowlist{Allowed: []string{"198.51.100.0/24"}}, remoteAddr: "198.51.101.42:443", want: http.StatusForbidden},
+		{name: "not an address", allowlist: IPAllowlist{TrustForwardedFor: true}, remoteAddr: "10.0.0.2:443", forwarded: []string{"unknown"}, want: http.StatusForbidden},
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/webhook", nil)
+			r.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			w := httptest.NewRecorder()
+			tt.allowlist.Wrap(next).ServeHTTP(w, r)
+			if w.Code != tt.want {
+				t.Errorf("got status %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
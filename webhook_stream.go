@@ -0,0 +1,131 @@
+package paystack
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Persists webhook events queued on an EventStream, so events that were acknowledged to Paystack but not yet consumed survive a restart.
+// Implementations must be safe for concurrent use.
+type EventStore interface {
+	// Stores the event before it is queued. Returning an error responds with a 500 so Paystack redelivers the event later.
+	// Must be idempotent per Event.Key, as Paystack may deliver an event again before it is deleted.
+	Save(ctx context.Context, event *Event) error
+	// Removes the event once its consumer acknowledged it with EventStream.Ack.
+	Delete(ctx context.Context, event *Event) error
+}
+
+type EventStreamOptions struct {
+	// How many events can be queued before webhook deliveries wait for a consumer. Defaults to 100.
+	Buffer int
+	// Where events are persisted while queued. Events are only held in memory if nil.
+	Store EventStore
+}
+
+// Exposes webhook events as a channel, so background workers can consume them in-process.
+// Deliveries wait while the buffer is full and respond with a 500 if the request is cancelled first, so Paystack redelivers instead of the event being dropped.
+// Safe for concurrent use.
+type EventStream struct {
+	events chan *Event
+	store  EventStore
+	done   chan struct{}
+	once   sync.Once
+	mu     sync.RWMutex
+	closed bool
+}
+
+// Returned when an event is delivered to an EventStream that has been closed.
+var ErrEventStreamClosed = errors.New("paystack: event stream closed")
+
+// Creates an event stream. Register it with a WebhookHandler using Attach, or pass its Handle method to On and OnUnknown.
+func NewEventStream(opts *EventStreamOptions) *EventStream {
+	if opts == nil {
+		opts = &EventStreamOptions{}
+	}
+	buffer := opts.Buffer
+	if buffer <= 0 {
+		buffer = 100
+	}
+	return &EventStream{events: make(chan *Event, buffer), store: opts.Store, done: make(chan struct{})}
+}
+
+// Registers the stream to receive events of the given types from h.
+func (s *EventStream) Attach(h *WebhookHandler, eventTypes ...EventType) {
+	for _, eventType := range eventTypes {
+		h.On(eventType, s.Handle)
+	}
+}
+
+// Saves the event to the stream's store, if any, and queues it, waiting while the buffer is full.
+// If the event cannot be queued it is deleted from the store again, as Paystack redelivers it.
+// To resume after a restart, pass the events still in the store to Restore before serving webhooks.
+func (s *EventStream) Handle(ctx context.Context, event *Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrEventStreamClosed
+	}
+	if s.store != nil {
+		if err := s.store.Save(ctx, event); err != nil {
+			return err
+		}
+	}
+	err := s.queue(ctx, event)
+	if err != nil && s.store != nil {
+		// The delivery's context may be done, but the event must not stay stored for a redelivery to save again.
+		if deleteErr := s.store.Delete(context.WithoutCancel(ctx), event); deleteErr != nil {
+			return errors.Join(err, deleteErr)
+		}
+	}
+	return err
+}
+
+// Queues an event loaded from the stream's store after a restart, waiting while the buffer is full.
+// The event is neither saved again nor deleted if it cannot be queued, as Paystack will not redeliver it.
+func (s *EventStream) Restore(ctx context.Context, event *Event) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return ErrEventStreamClosed
+	}
+	return s.queue(ctx, event)
+}
+
+// Sends the event on the events channel. The caller must hold a read lock and have checked the stream is open.
+func (s *EventStream) queue(ctx context.Context, event *Event) error {
+	select {
+	case s.events <- event:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.done:
+		return ErrEventStreamClosed
+	}
+}
+
+// Returns the channel queued events are received from. It is closed by Close.
+func (s *EventStream) Events() <-chan *Event {
+	return s.events
+}
+
+// Marks the event as consumed, deleting it from the stream's store, if any.
+func (s *EventStream) Ack(ctx context.Context, event *Event) error {
+	if s.store == nil {
+		return nil
+	}
+	return s.store.Delete(ctx, event)
+}
+
+// Stops accepting events and closes the Events channel once deliveries in progress have returned.
+// Events still buffered can be received until the channel is drained.
+func (s *EventStream) Close() {
+	s.once.Do(func() {
+		// Unblocks deliveries waiting for buffer space, so they release their read locks.
+		close(s.done)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.closed = true
+		close(s.events)
+	})
+}
@@ -0,0 +1,42 @@
+package paystack
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := []byte(`{"event":"charge.success","data":{"id":1}}`)
+	mac := hmac.New(sha512.New, []byte("sk_test_secret"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+	mac = hmac.New(sha512.New, nil)
+	mac.Write(payload)
+	emptyKeySignature := hex.EncodeToString(mac.Sum(nil))
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		secret    string
+		want      bool
+	}{
+		{name: "valid", payload: payload, signature: signature, secret: "sk_test_secret", want: true},
+		{name: "upper case hex", payload: payload, signature: strings.ToUpper(signature), secret: "sk_test_secret", want: true},
+		{name: "wrong secret", payload: payload, signature: signature, secret: "sk_test_other"},
+		{name: "tampered payload", payload: []byte(`{"event":"charge.success","data":{"id":2}}`), signature: signature, secret: "sk_test_secret"},
+		{name: "truncated signature", payload: payload, signature: signature[:64], secret: "sk_test_secret"},
+		{name: "not hex", payload: payload, signature: "zz" + signature[2:], secret: "sk_test_secret"},
+		{name: "empty", payload: payload, signature: "", secret: "sk_test_secret"},
+		{name: "empty secret", payload: payload, signature: emptyKeySignature, secret: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifyWebhookSignature(tt.payload, tt.signature, tt.secret); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Package webhooktest builds signed Paystack webhook requests for testing webhook handlers without contacting Paystack.
+package webhooktest
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/acudac-com/paystack-go"
+)
+
+// The address requests are sent from, the first of paystack.PaystackWebhookIPs, so they pass a default paystack.IPAllowlist.
+var RemoteAddr = paystack.PaystackWebhookIPs[0] + ":443"
+
+// Returns the x-paystack-signature header value for payload signed with the given secret key.
+func Sign(payload []byte, secret string) string {
+	mac := hmac.New(sha512.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Returns a signed webhook request for the given event type, with data marshalled as the event's payload.
+// Panics if data cannot be marshalled.
+func NewRequest(secret string, eventType paystack.EventType, data any) *http.Request {
+	rawData, err := json.Marshal(data)
+	if err != nil {
+		panic(err)
+	}
+	payload, err := json.Marshal(&paystack.Event{Event: eventType, Data: rawData})
+	if err != nil {
+		panic(err)
+	}
+	return NewRawRequest(secret, payload)
+}
+
+// Returns a webhook request with payload as its body, signed with the given secret key.
+func NewRawRequest(secret string, payload []byte) *http.Request {
+	req := httptest.NewRequest("POST", "/", bytes.NewReader(payload))
+	req.RemoteAddr = RemoteAddr
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-paystack-signature", Sign(payload, secret))
+	return req
+}
+
+// Serves a signed webhook request for the event through handler and returns the recorded response.
+func Deliver(handler http.Handler, secret string, eventType paystack.EventType, data any) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, NewRequest(secret, eventType, data))
+	return rec
+}